@@ -3,7 +3,8 @@ package valet
 import (
 	"context"
 	"database/sql"
-	"strings"
+	"fmt"
+	"math"
 )
 
 // DBQuerier is a minimal interface that both *sql.DB and *sql.Tx satisfy
@@ -13,7 +14,8 @@ type DBQuerier interface {
 
 // SQLAdapter implements DBChecker for standard database/sql
 type SQLAdapter struct {
-	db DBQuerier
+	db      DBQuerier
+	dialect Dialect
 }
 
 // NewSQLAdapter creates a checker for database/sql compatible connections
@@ -26,7 +28,13 @@ func NewSQLChecker(db *sql.DB) *SQLAdapter {
 	return NewSQLAdapter(db)
 }
 
-// CheckExists implements DBChecker using a single IN query
+// WithDialect sets the SQL dialect used to build queries
+func (s *SQLAdapter) WithDialect(d Dialect) *SQLAdapter {
+	s.dialect = d
+	return s
+}
+
+// CheckExists implements DBChecker using batched IN queries
 func (s *SQLAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
 	if len(values) == 0 {
 		return make(map[any]bool), nil
@@ -36,26 +44,153 @@ func (s *SQLAdapter) CheckExists(ctx context.Context, table string, column strin
 		return nil, ErrNilDBConnection
 	}
 
-	query, args := buildExistsQuery(table, column, values, wheres)
-
-	rows, err := s.db.QueryContext(ctx, query, args...)
+	result := make(map[any]bool, len(values))
+	queries, err := buildExistsQueries(s.dialect, table, column, values, wheres)
 	if err != nil {
 		return nil, err
 	}
+
+	for _, q := range queries {
+		if err := s.query(ctx, q, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *SQLAdapter) query(ctx context.Context, q existsQuery, result map[any]bool) error {
+	rows, err := s.db.QueryContext(ctx, q.SQL, q.Args...)
+	if err != nil {
+		return err
+	}
 	defer func() { _ = rows.Close() }()
 
-	result := make(map[any]bool, len(values))
 	for rows.Next() {
 		var val any
 		if err := rows.Scan(&val); err != nil {
+			return err
+		}
+		result[NormalizeDBKey(val)] = true
+	}
+
+	return rows.Err()
+}
+
+// LoadRows implements DBLoader, selecting whole rows for the values
+func (s *SQLAdapter) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	if len(values) == 0 {
+		return make(map[any]map[string]any), nil
+	}
+
+	if s.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	queries, err := buildLoadQueries(s.dialect, table, column, values, wheres)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[any]map[string]any, len(values))
+	for _, q := range queries {
+		if err := s.load(ctx, q, column, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *SQLAdapter) load(ctx context.Context, q existsQuery, column string, result map[any]map[string]any) error {
+	rows, err := s.db.QueryContext(ctx, q.SQL, q.Args...)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		vals := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return err
+		}
+
+		row := make(map[string]any, len(cols))
+		for i, col := range cols {
+			if b, ok := vals[i].([]byte); ok {
+				row[col] = string(b)
+			} else {
+				row[col] = vals[i]
+			}
+		}
+		addLoadedRow(result, row, column)
+	}
+
+	return rows.Err()
+}
+
+// addLoadedRow stores a row under the normalized value of its matched column
+func addLoadedRow(result map[any]map[string]any, row map[string]any, column string) {
+	if v, ok := rowValue(row, column); ok && v != nil {
+		result[NormalizeDBKey(v)] = row
+	}
+}
+
+// Dialect returns the dialect used to render queries
+func (s *SQLAdapter) Dialect() Dialect {
+	return dialectOrDefault(s.dialect)
+}
+
+// QueryColumn implements RawQuerier
+func (s *SQLAdapter) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	if s.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []any
+	for rows.Next() {
+		vals := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
 			return nil, err
 		}
-		result[val] = true
+		if len(vals) > 0 {
+			result = append(result, vals[0])
+		}
 	}
 
 	return result, rows.Err()
 }
 
+// dialectOrDefault returns d, or DefaultDialect when d is nil
+func dialectOrDefault(d Dialect) Dialect {
+	if d == nil {
+		return DefaultDialect
+	}
+	return d
+}
+
 // FuncAdapter allows using a simple function as DBChecker
 type FuncAdapter func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error)
 
@@ -70,7 +205,8 @@ type SQLXQuerier interface {
 
 // SQLXAdapter implements DBChecker for jmoiron/sqlx
 type SQLXAdapter struct {
-	db SQLXQuerier
+	db      SQLXQuerier
+	dialect Dialect
 }
 
 // NewSQLXAdapter creates a checker for sqlx
@@ -78,6 +214,12 @@ func NewSQLXAdapter(db SQLXQuerier) *SQLXAdapter {
 	return &SQLXAdapter{db: db}
 }
 
+// WithDialect sets the SQL dialect used to build queries
+func (s *SQLXAdapter) WithDialect(d Dialect) *SQLXAdapter {
+	s.dialect = d
+	return s
+}
+
 func (s *SQLXAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
 	if len(values) == 0 {
 		return make(map[any]bool), nil
@@ -87,20 +229,60 @@ func (s *SQLXAdapter) CheckExists(ctx context.Context, table string, column stri
 		return nil, ErrNilDBConnection
 	}
 
-	query, args := buildExistsQuery(table, column, values, wheres)
-
-	var results []interface{}
-	if err := s.db.SelectContext(ctx, &results, query, args...); err != nil {
+	resultMap := make(map[any]bool, len(values))
+	queries, err := buildExistsQueries(s.dialect, table, column, values, wheres)
+	if err != nil {
 		return nil, err
 	}
 
-	resultMap := make(map[any]bool, len(results))
-	for _, v := range results {
-		resultMap[v] = true
+	for _, q := range queries {
+		var results []interface{}
+		if err := s.db.SelectContext(ctx, &results, q.SQL, q.Args...); err != nil {
+			return nil, err
+		}
+		for _, v := range results {
+			resultMap[NormalizeDBKey(v)] = true
+		}
 	}
 	return resultMap, nil
 }
 
+// LoadRows implements DBLoader. The rows are read through QueryContext, which
+// *sqlx.DB and *sqlx.Tx inherit from database/sql; other queriers return
+// ErrLoadNotSupported.
+func (s *SQLXAdapter) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	if len(values) == 0 {
+		return make(map[any]map[string]any), nil
+	}
+
+	if s.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	db, ok := s.db.(DBQuerier)
+	if !ok {
+		return nil, ErrLoadNotSupported
+	}
+	return (&SQLAdapter{db: db, dialect: s.dialect}).LoadRows(ctx, table, column, values, wheres)
+}
+
+// Dialect returns the dialect used to render queries
+func (s *SQLXAdapter) Dialect() Dialect {
+	return dialectOrDefault(s.dialect)
+}
+
+// QueryColumn implements RawQuerier
+func (s *SQLXAdapter) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	if s.db == nil {
+		return nil, ErrNilDBConnection
+	}
+	var results []interface{}
+	if err := s.db.SelectContext(ctx, &results, query, args...); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // GormQuerier is a simple interface for GORM-like ORMs
 type GormQuerier interface {
 	Raw(ctx context.Context, sql string, values ...interface{}) GormResult
@@ -114,6 +296,7 @@ type GormResult interface {
 // GormAdapter implements DBChecker for GORM-like ORMs
 type GormAdapter struct {
 	querier GormQuerier
+	dialect Dialect
 }
 
 // NewGormAdapter creates an adapter for GORM-like ORMs
@@ -121,6 +304,12 @@ func NewGormAdapter(q GormQuerier) *GormAdapter {
 	return &GormAdapter{querier: q}
 }
 
+// WithDialect sets the SQL dialect used to build queries
+func (g *GormAdapter) WithDialect(d Dialect) *GormAdapter {
+	g.dialect = d
+	return g
+}
+
 func (g *GormAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
 	if len(values) == 0 {
 		return make(map[any]bool), nil
@@ -130,20 +319,69 @@ func (g *GormAdapter) CheckExists(ctx context.Context, table string, column stri
 		return nil, ErrNilDBConnection
 	}
 
-	query, args := buildExistsQuery(table, column, values, wheres)
-
-	var results []interface{}
-	if err := g.querier.Raw(ctx, query, args...).Scan(&results); err != nil {
+	resultMap := make(map[any]bool, len(values))
+	queries, err := buildExistsQueries(g.dialect, table, column, values, wheres)
+	if err != nil {
 		return nil, err
 	}
 
-	resultMap := make(map[any]bool, len(results))
-	for _, v := range results {
-		resultMap[v] = true
+	for _, q := range queries {
+		var results []interface{}
+		if err := g.querier.Raw(ctx, q.SQL, q.Args...).Scan(&results); err != nil {
+			return nil, err
+		}
+		for _, v := range results {
+			resultMap[NormalizeDBKey(v)] = true
+		}
 	}
 	return resultMap, nil
 }
 
+// LoadRows implements DBLoader, scanning whole rows into maps
+func (g *GormAdapter) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	if len(values) == 0 {
+		return make(map[any]map[string]any), nil
+	}
+
+	if g.querier == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	queries, err := buildLoadQueries(g.dialect, table, column, values, wheres)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[any]map[string]any, len(values))
+	for _, q := range queries {
+		var rows []map[string]any
+		if err := g.querier.Raw(ctx, q.SQL, q.Args...).Scan(&rows); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			addLoadedRow(result, row, column)
+		}
+	}
+	return result, nil
+}
+
+// Dialect returns the dialect used to render queries
+func (g *GormAdapter) Dialect() Dialect {
+	return dialectOrDefault(g.dialect)
+}
+
+// QueryColumn implements RawQuerier
+func (g *GormAdapter) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	if g.querier == nil {
+		return nil, ErrNilDBConnection
+	}
+	var results []interface{}
+	if err := g.querier.Raw(ctx, query, args...).Scan(&results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // BunQuerier interface for uptrace/bun compatibility
 type BunQuerier interface {
 	NewRaw(query string, args ...interface{}) BunRawQuery
@@ -155,7 +393,8 @@ type BunRawQuery interface {
 
 // BunAdapter implements DBChecker for uptrace/bun
 type BunAdapter struct {
-	db BunQuerier
+	db      BunQuerier
+	dialect Dialect
 }
 
 // NewBunAdapter creates a checker for bun ORM
@@ -163,6 +402,12 @@ func NewBunAdapter(db BunQuerier) *BunAdapter {
 	return &BunAdapter{db: db}
 }
 
+// WithDialect sets the SQL dialect used to build queries
+func (b *BunAdapter) WithDialect(d Dialect) *BunAdapter {
+	b.dialect = d
+	return b
+}
+
 func (b *BunAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
 	if len(values) == 0 {
 		return make(map[any]bool), nil
@@ -172,49 +417,227 @@ func (b *BunAdapter) CheckExists(ctx context.Context, table string, column strin
 		return nil, ErrNilDBConnection
 	}
 
-	query, args := buildExistsQuery(table, column, values, wheres)
+	resultMap := make(map[any]bool, len(values))
+	queries, err := buildExistsQueries(b.dialect, table, column, values, wheres)
+	if err != nil {
+		return nil, err
+	}
 
+	for _, q := range queries {
+		var results []interface{}
+		if err := b.db.NewRaw(q.SQL, q.Args...).Scan(ctx, &results); err != nil {
+			return nil, err
+		}
+		for _, v := range results {
+			resultMap[NormalizeDBKey(v)] = true
+		}
+	}
+	return resultMap, nil
+}
+
+// LoadRows implements DBLoader, scanning whole rows into maps
+func (b *BunAdapter) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	if len(values) == 0 {
+		return make(map[any]map[string]any), nil
+	}
+
+	if b.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	queries, err := buildLoadQueries(b.dialect, table, column, values, wheres)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[any]map[string]any, len(values))
+	for _, q := range queries {
+		var rows []map[string]any
+		if err := b.db.NewRaw(q.SQL, q.Args...).Scan(ctx, &rows); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			addLoadedRow(result, row, column)
+		}
+	}
+	return result, nil
+}
+
+// Dialect returns the dialect used to render queries
+func (b *BunAdapter) Dialect() Dialect {
+	return dialectOrDefault(b.dialect)
+}
+
+// QueryColumn implements RawQuerier
+func (b *BunAdapter) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	if b.db == nil {
+		return nil, ErrNilDBConnection
+	}
 	var results []interface{}
 	if err := b.db.NewRaw(query, args...).Scan(ctx, &results); err != nil {
 		return nil, err
 	}
+	return results, nil
+}
+
+// PgxRows is the subset of pgx.Rows used by PgxAdapter
+type PgxRows interface {
+	Next() bool
+	Values() ([]any, error)
+	Err() error
+	Close()
+}
+
+// PgxQuerier is a pgx-style connection (e.g., a thin wrapper around
+// *pgx.Conn or *pgxpool.Pool whose Query returns pgx.Rows)
+type PgxQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (PgxRows, error)
+}
+
+// PgxAdapter implements DBChecker for jackc/pgx. Value lists are bound as a
+// single array parameter ("= ANY($1)"), so batches are never chunked.
+type PgxAdapter struct {
+	db PgxQuerier
+}
 
-	resultMap := make(map[any]bool, len(results))
-	for _, v := range results {
-		resultMap[v] = true
+// NewPgxAdapter creates a checker for pgx
+func NewPgxAdapter(db PgxQuerier) *PgxAdapter {
+	return &PgxAdapter{db: db}
+}
+
+// CheckExists implements DBChecker using "= ANY($1)"
+func (p *PgxAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	if len(values) == 0 {
+		return make(map[any]bool), nil
 	}
-	return resultMap, nil
+
+	if p.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	queries, err := buildExistsQueries(p.Dialect(), table, column, values, wheres)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[any]bool, len(values))
+	for _, q := range queries {
+		vals, err := p.QueryColumn(ctx, q.SQL, q.Args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vals {
+			result[NormalizeDBKey(v)] = true
+		}
+	}
+	return result, nil
 }
 
-// buildExistsQuery builds the SQL query for existence check
-func buildExistsQuery(table, column string, values []any, wheres []WhereClause) (string, []any) {
-	var query strings.Builder
-	query.WriteString("SELECT ")
-	query.WriteString(column)
-	query.WriteString(" FROM ")
-	query.WriteString(table)
-	query.WriteString(" WHERE ")
-	query.WriteString(column)
-	query.WriteString(" IN (")
+// LoadRows implements DBLoader. PgxRows carries no column names, so each row
+// is selected as a jsonb object; values come back as JSON types (numbers are
+// float64, timestamps are strings).
+func (p *PgxAdapter) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	if len(values) == 0 {
+		return make(map[any]map[string]any), nil
+	}
 
-	placeholders := make([]string, len(values))
-	for i := range values {
-		placeholders[i] = "?"
+	if p.db == nil {
+		return nil, ErrNilDBConnection
 	}
-	query.WriteString(strings.Join(placeholders, ","))
-	query.WriteString(")")
 
-	args := make([]any, len(values))
-	copy(args, values)
+	queries, err := buildLoadQueries(p.Dialect(), table, column, values, wheres)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, w := range wheres {
-		query.WriteString(" AND ")
-		query.WriteString(w.Column)
-		query.WriteString(" ")
-		query.WriteString(w.Operator)
-		query.WriteString(" ?")
-		args = append(args, w.Value)
+	result := make(map[any]map[string]any, len(values))
+	for _, q := range queries {
+		vals, err := p.QueryColumn(ctx, "SELECT to_jsonb(t) FROM ("+q.SQL+") AS t", q.Args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vals {
+			row, ok := v.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("valet: pgx returned %T for a jsonb row", v)
+			}
+			addLoadedRow(result, row, column)
+		}
 	}
+	return result, nil
+}
+
+// Dialect returns PostgresDialect with pgx array binding
+func (p *PgxAdapter) Dialect() Dialect {
+	return PostgresDialect{Array: pgxArray}
+}
 
-	return query.String(), args
+// QueryColumn implements RawQuerier
+func (p *PgxAdapter) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	if p.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []any
+	for rows.Next() {
+		vals, err := rows.Values()
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) > 0 {
+			result = append(result, vals[0])
+		}
+	}
+	return result, rows.Err()
+}
+
+// pgxArray converts a value list into a typed slice pgx can encode as a
+// Postgres array: []string, []int64 (integral JSON numbers included) or
+// []float64, falling back to []any for mixed lists
+func pgxArray(values []any) any {
+	var strs []string
+	var ints []int64
+	var floats []float64
+	for _, v := range values {
+		switch n := v.(type) {
+		case string:
+			strs = append(strs, n)
+		case int:
+			ints = append(ints, int64(n))
+		case int32:
+			ints = append(ints, int64(n))
+		case int64:
+			ints = append(ints, n)
+		case float64:
+			if n == math.Trunc(n) && math.Abs(n) < 1<<53 {
+				ints = append(ints, int64(n))
+			}
+			floats = append(floats, n)
+		}
+	}
+
+	switch len(values) {
+	case len(strs):
+		return strs
+	case len(ints):
+		return ints
+	case len(floats):
+		return floats
+	}
+	return values
+}
+
+// buildExistsQuery builds the SQL query for existence check using DefaultDialect
+func buildExistsQuery(table, column string, values []any, wheres []WhereClause) (string, []any, error) {
+	if err := validateExistsQuery(table, column, wheres); err != nil {
+		return "", nil, err
+	}
+	query, args := renderExistsQuery(DefaultDialect, table, column, values, nil, wheres, false)
+	return query, args, nil
 }
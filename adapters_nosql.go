@@ -0,0 +1,196 @@
+package valet
+
+import (
+	"context"
+	"fmt"
+)
+
+// DocumentFinder is the minimal query surface of a document store. Distinct
+// returns the distinct values of field among the documents of collection that
+// match filter (e.g., mongo.Collection.Distinct).
+type DocumentFinder interface {
+	Distinct(ctx context.Context, collection string, field string, filter map[string]any) ([]any, error)
+}
+
+// DocumentAdapter implements DBChecker for document stores. The table is the
+// collection, the column is the field, and values are matched with $in. Where
+// clauses become query operators ($ne, $gt, $nin, $regex, $or, ...).
+type DocumentAdapter struct {
+	db DocumentFinder
+}
+
+// NewDocumentAdapter creates a checker for a document store
+func NewDocumentAdapter(db DocumentFinder) *DocumentAdapter {
+	return &DocumentAdapter{db: db}
+}
+
+// CheckExists implements DBChecker with a single Distinct query
+func (d *DocumentAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	if len(values) == 0 {
+		return make(map[any]bool), nil
+	}
+
+	if d.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	if err := validateExistsQuery(table, column, wheres); err != nil {
+		return nil, err
+	}
+
+	found, err := d.db.Distinct(ctx, table, column, documentFilter(column, values, wheres))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[any]bool, len(found))
+	for _, v := range found {
+		result[NormalizeDBKey(v)] = true
+	}
+	return result, nil
+}
+
+// documentFilter builds {column: {$in: values}, $and: [wheres...]}
+func documentFilter(column string, values []any, wheres []WhereClause) map[string]any {
+	filter := map[string]any{column: map[string]any{"$in": values}}
+	if len(wheres) == 0 {
+		return filter
+	}
+
+	and := make([]any, len(wheres))
+	for i, w := range wheres {
+		and[i] = documentWhere(w)
+	}
+	filter["$and"] = and
+	return filter
+}
+
+// documentWhere translates a validated clause into a query operator
+func documentWhere(w WhereClause) map[string]any {
+	op := normalizeOperator(w.Operator)
+
+	if op == "OR" {
+		or := make([]any, len(w.Or))
+		for i, sub := range w.Or {
+			or[i] = documentWhere(sub)
+		}
+		return map[string]any{"$or": or}
+	}
+
+	field := func(cond any) map[string]any {
+		return map[string]any{w.Column: cond}
+	}
+
+	switch op {
+	case "=":
+		return field(map[string]any{"$eq": w.Value})
+	case "!=", "<>":
+		return field(map[string]any{"$ne": w.Value})
+	case "<":
+		return field(map[string]any{"$lt": w.Value})
+	case "<=":
+		return field(map[string]any{"$lte": w.Value})
+	case ">":
+		return field(map[string]any{"$gt": w.Value})
+	case ">=":
+		return field(map[string]any{"$gte": w.Value})
+	case "IN":
+		list, _ := toAnySlice(w.Value)
+		return field(map[string]any{"$in": list})
+	case "NOT IN":
+		list, _ := toAnySlice(w.Value)
+		return field(map[string]any{"$nin": list})
+	case "IS NULL":
+		return field(map[string]any{"$eq": nil})
+	case "IS NOT NULL":
+		return field(map[string]any{"$ne": nil})
+	case "LIKE":
+		pattern, _ := w.Value.(string)
+		return field(map[string]any{"$regex": likeRegexp(pattern).String()})
+	case "NOT LIKE":
+		pattern, _ := w.Value.(string)
+		return field(map[string]any{"$not": map[string]any{"$regex": likeRegexp(pattern).String()}})
+	case "BETWEEN":
+		bounds, _ := toAnySlice(w.Value)
+		return field(map[string]any{"$gte": bounds[0], "$lte": bounds[1]})
+	case "NOT BETWEEN":
+		bounds, _ := toAnySlice(w.Value)
+		return map[string]any{"$or": []any{
+			field(map[string]any{"$lt": bounds[0]}),
+			field(map[string]any{"$gt": bounds[1]}),
+		}}
+	}
+	return field(w.Value)
+}
+
+// SetMembership is the minimal surface of a key-value store with sets.
+// IsMembers reports, in order, whether each member belongs to the set stored
+// at key (e.g., Redis SMISMEMBER).
+type SetMembership interface {
+	IsMembers(ctx context.Context, key string, members []string) ([]bool, error)
+}
+
+// KVSetAdapter implements DBChecker for key-value sets. Each table/column pair
+// maps to one set key ("table:column" by default) whose members are the
+// string form of the normalized values. Where clauses are not supported.
+type KVSetAdapter struct {
+	db      SetMembership
+	keyFunc func(table, column string) string
+}
+
+// NewKVSetAdapter creates a checker for key-value sets
+func NewKVSetAdapter(db SetMembership) *KVSetAdapter {
+	return &KVSetAdapter{db: db}
+}
+
+// WithKeyFunc sets how a table/column pair maps to a set key
+func (k *KVSetAdapter) WithKeyFunc(fn func(table, column string) string) *KVSetAdapter {
+	k.keyFunc = fn
+	return k
+}
+
+// CheckExists implements DBChecker with a single set-membership query
+func (k *KVSetAdapter) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	if len(values) == 0 {
+		return make(map[any]bool), nil
+	}
+
+	if k.db == nil {
+		return nil, ErrNilDBConnection
+	}
+
+	if len(wheres) > 0 {
+		return nil, ErrWhereNotSupported
+	}
+	if err := validateExistsQuery(table, column, nil); err != nil {
+		return nil, err
+	}
+
+	key := table + ":" + column
+	if k.keyFunc != nil {
+		key = k.keyFunc(table, column)
+	}
+
+	keys := make([]any, len(values))
+	members := make([]string, len(values))
+	for i, v := range values {
+		keys[i] = NormalizeDBKey(v)
+		members[i] = fmt.Sprint(keys[i])
+	}
+
+	found, err := k.db.IsMembers(ctx, key, members)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(members) {
+		return nil, fmt.Errorf("set membership returned %d results for %d members", len(found), len(members))
+	}
+
+	result := make(map[any]bool, len(values))
+	for i, ok := range found {
+		if ok {
+			result[keys[i]] = true
+		}
+	}
+	return result, nil
+}
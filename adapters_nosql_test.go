@@ -0,0 +1,232 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakeDocumentStore evaluates the filters DocumentAdapter builds against
+// in-memory documents
+type fakeDocumentStore struct {
+	docs       map[string][]map[string]any
+	collection string
+	filter     map[string]any
+}
+
+func (f *fakeDocumentStore) Distinct(ctx context.Context, collection, field string, filter map[string]any) ([]any, error) {
+	f.collection = collection
+	f.filter = filter
+
+	seen := make(map[any]bool)
+	var result []any
+	for _, doc := range f.docs[collection] {
+		if !matchDocument(doc, filter) {
+			continue
+		}
+		if v, ok := doc[field]; ok && !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+func matchDocument(doc map[string]any, filter map[string]any) bool {
+	for key, cond := range filter {
+		switch key {
+		case "$and":
+			for _, sub := range cond.([]any) {
+				if !matchDocument(doc, sub.(map[string]any)) {
+					return false
+				}
+			}
+		case "$or":
+			matched := false
+			for _, sub := range cond.([]any) {
+				if matchDocument(doc, sub.(map[string]any)) {
+					matched = true
+				}
+			}
+			if !matched {
+				return false
+			}
+		default:
+			if !matchOperators(doc[key], cond.(map[string]any)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchOperators(val any, ops map[string]any) bool {
+	for op, arg := range ops {
+		c, ok := compareDBValues(val, arg)
+		switch op {
+		case "$eq":
+			if arg == nil {
+				if val != nil {
+					return false
+				}
+			} else if !ok || c != 0 {
+				return false
+			}
+		case "$ne":
+			if arg == nil {
+				if val == nil {
+					return false
+				}
+			} else if ok && c == 0 {
+				return false
+			}
+		case "$gt", "$gte", "$lt", "$lte":
+			if !ok || (op == "$gt" && c <= 0) || (op == "$gte" && c < 0) || (op == "$lt" && c >= 0) || (op == "$lte" && c > 0) {
+				return false
+			}
+		case "$in", "$nin":
+			in := false
+			for _, item := range arg.([]any) {
+				if c, ok := compareDBValues(val, item); ok && c == 0 {
+					in = true
+				}
+			}
+			if in != (op == "$in") {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func TestDocumentAdapter_CheckExists(t *testing.T) {
+	store := &fakeDocumentStore{docs: map[string][]map[string]any{
+		"users": {
+			{"_id": "u1", "email": "a@example.com", "status": "active", "age": int64(30)},
+			{"_id": "u2", "email": "b@example.com", "status": "banned", "age": int64(17)},
+			{"_id": "u3", "email": "c@example.com", "status": "active", "age": int64(12)},
+		},
+	}}
+	adapter := NewDocumentAdapter(store)
+
+	result, err := adapter.CheckExists(context.Background(), "users", "_id", []any{"u1", "u2", "u3", "u4"}, []WhereClause{
+		WhereEq("status", "active"),
+		WhereOr(Where("age", ">=", 18), WhereIn("email", "c@example.com")),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, map[any]bool{"u1": true, "u3": true}) {
+		t.Errorf("Unexpected result: %v", result)
+	}
+
+	want := map[string]any{
+		"_id": map[string]any{"$in": []any{"u1", "u2", "u3", "u4"}},
+		"$and": []any{
+			map[string]any{"status": map[string]any{"$eq": "active"}},
+			map[string]any{"$or": []any{
+				map[string]any{"age": map[string]any{"$gte": 18}},
+				map[string]any{"email": map[string]any{"$in": []any{"c@example.com"}}},
+			}},
+		},
+	}
+	if store.collection != "users" || !reflect.DeepEqual(store.filter, want) {
+		t.Errorf("Filter = %v, want %v", store.filter, want)
+	}
+}
+
+func TestDocumentWhere(t *testing.T) {
+	tests := []struct {
+		where WhereClause
+		want  map[string]any
+	}{
+		{WhereNot("a", 1), map[string]any{"a": map[string]any{"$ne": 1}}},
+		{WhereNotIn("a", 1, 2), map[string]any{"a": map[string]any{"$nin": []any{1, 2}}}},
+		{WhereNull("a"), map[string]any{"a": map[string]any{"$eq": nil}}},
+		{WhereNotNull("a"), map[string]any{"a": map[string]any{"$ne": nil}}},
+		{WhereLike("a", "x%"), map[string]any{"a": map[string]any{"$regex": "(?s)^x.*$"}}},
+		{WhereBetween("a", 1, 5), map[string]any{"a": map[string]any{"$gte": 1, "$lte": 5}}},
+		{Where("a", "NOT BETWEEN", []any{1, 5}), map[string]any{"$or": []any{
+			map[string]any{"a": map[string]any{"$lt": 1}},
+			map[string]any{"a": map[string]any{"$gt": 5}},
+		}}},
+	}
+
+	for _, tt := range tests {
+		if got := documentWhere(tt.where); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("documentWhere(%v) = %v, want %v", tt.where, got, tt.want)
+		}
+	}
+}
+
+func TestDocumentAdapter_RejectsInvalidQueries(t *testing.T) {
+	adapter := NewDocumentAdapter(&fakeDocumentStore{})
+
+	_, err := adapter.CheckExists(context.Background(), "users", "$where", []any{1}, nil)
+	if !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("Expected ErrInvalidIdentifier, got %v", err)
+	}
+
+	_, err = NewDocumentAdapter(nil).CheckExists(context.Background(), "users", "_id", []any{1}, nil)
+	if !errors.Is(err, ErrNilDBConnection) {
+		t.Errorf("Expected ErrNilDBConnection, got %v", err)
+	}
+}
+
+// fakeSetStore is a key-value store of string sets
+type fakeSetStore struct {
+	mu   sync.Mutex
+	sets map[string]map[string]bool
+	keys []string
+}
+
+func (f *fakeSetStore) IsMembers(ctx context.Context, key string, members []string) ([]bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys = append(f.keys, key)
+	result := make([]bool, len(members))
+	for i, m := range members {
+		result[i] = f.sets[key][m]
+	}
+	return result, nil
+}
+
+func TestKVSetAdapter_CheckExists(t *testing.T) {
+	store := &fakeSetStore{sets: map[string]map[string]bool{
+		"countries:code": {"us": true, "de": true},
+		"plans:id":       {"5": true},
+	}}
+	adapter := NewKVSetAdapter(store)
+
+	data := DataObject{"country": "us", "plan": float64(5), "other": "xx"}
+	schema := Schema{
+		"country": String().Exists("countries", "code"),
+		"plan":    Float().Exists("plans", "id"),
+		"other":   String().Exists("countries", "code"),
+	}
+
+	verr := ValidateWithDB(context.Background(), data, schema, adapter)
+	if verr == nil || len(verr.Errors) != 1 || verr.Errors["other"] == nil {
+		t.Fatalf("Unexpected result: %v", verr)
+	}
+
+	adapter.WithKeyFunc(func(table, column string) string { return "ref:" + table })
+	store.keys = nil
+	_, _ = adapter.CheckExists(context.Background(), "plans", "id", []any{1}, nil)
+	if len(store.keys) != 1 || store.keys[0] != "ref:plans" {
+		t.Errorf("Keys = %v", store.keys)
+	}
+}
+
+func TestKVSetAdapter_RejectsWheres(t *testing.T) {
+	adapter := NewKVSetAdapter(&fakeSetStore{})
+
+	_, err := adapter.CheckExists(context.Background(), "users", "id", []any{1}, []WhereClause{WhereEq("active", true)})
+	if !errors.Is(err, ErrWhereNotSupported) {
+		t.Errorf("Expected ErrWhereNotSupported, got %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package valet
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -64,7 +65,7 @@ func TestFuncAdapter(t *testing.T) {
 
 func TestBuildExistsQuery(t *testing.T) {
 	t.Run("simple query", func(t *testing.T) {
-		query, args := buildExistsQuery("users", "id", []any{1, 2, 3}, nil)
+		query, args, _ := buildExistsQuery("users", "id", []any{1, 2, 3}, nil)
 
 		expectedQuery := "SELECT id FROM users WHERE id IN (?,?,?)"
 		if query != expectedQuery {
@@ -81,7 +82,7 @@ func TestBuildExistsQuery(t *testing.T) {
 			{Column: "status", Operator: "=", Value: "active"},
 			{Column: "deleted", Operator: "!=", Value: true},
 		}
-		query, args := buildExistsQuery("users", "email", []any{"a@test.com"}, wheres)
+		query, args, _ := buildExistsQuery("users", "email", []any{"a@test.com"}, wheres)
 
 		if len(args) != 3 { // 1 value + 2 where values
 			t.Errorf("Args length = %d, want 3", len(args))
@@ -94,7 +95,7 @@ func TestBuildExistsQuery(t *testing.T) {
 	})
 
 	t.Run("empty values", func(t *testing.T) {
-		query, args := buildExistsQuery("users", "id", []any{}, nil)
+		query, args, _ := buildExistsQuery("users", "id", []any{}, nil)
 
 		expectedQuery := "SELECT id FROM users WHERE id IN ()"
 		if query != expectedQuery {
@@ -660,3 +661,98 @@ func TestAdapterIntegrationWithValidation(t *testing.T) {
 		}
 	})
 }
+
+// fakePgxRows serves canned single-column rows
+type fakePgxRows struct {
+	vals []any
+	i    int
+}
+
+func (r *fakePgxRows) Next() bool             { r.i++; return r.i <= len(r.vals) }
+func (r *fakePgxRows) Values() ([]any, error) { return []any{r.vals[r.i-1]}, nil }
+func (r *fakePgxRows) Err() error             { return nil }
+func (r *fakePgxRows) Close()                 {}
+
+type fakePgx struct {
+	sql  string
+	args []any
+	rows []any
+}
+
+func (f *fakePgx) Query(ctx context.Context, sql string, args ...any) (PgxRows, error) {
+	f.sql, f.args = sql, args
+	return &fakePgxRows{vals: f.rows}, nil
+}
+
+func TestPgxAdapter_CheckExists(t *testing.T) {
+	db := &fakePgx{rows: []any{int64(1), int64(3)}}
+	adapter := NewPgxAdapter(db)
+
+	result, err := adapter.CheckExists(context.Background(), "users", "id", []any{float64(1), float64(2), float64(3)}, []WhereClause{WhereEq("active", true)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := `SELECT "id" FROM "users" WHERE "id" = ANY($1) AND "active" = $2`; db.sql != want {
+		t.Errorf("SQL = %q, want %q", db.sql, want)
+	}
+	if ids, ok := db.args[0].([]int64); !ok || len(ids) != 3 {
+		t.Errorf("Expected []int64 array argument, got %T %v", db.args[0], db.args[0])
+	}
+	if len(result) != 2 || !result[int64(1)] || !result[int64(3)] {
+		t.Errorf("Unexpected result: %v", result)
+	}
+
+	if _, err := NewPgxAdapter(nil).CheckExists(context.Background(), "users", "id", []any{1}, nil); !errors.Is(err, ErrNilDBConnection) {
+		t.Errorf("Expected ErrNilDBConnection, got %v", err)
+	}
+}
+
+func TestPgxAdapter_LoadRows(t *testing.T) {
+	db := &fakePgx{rows: []any{map[string]any{"id": float64(1), "name": "Ann"}}}
+	rows, err := NewPgxAdapter(db).LoadRows(context.Background(), "users", "id", []any{float64(1), float64(2)}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := `SELECT to_jsonb(t) FROM (SELECT * FROM "users" WHERE "id" = ANY($1)) AS t`; db.sql != want {
+		t.Errorf("SQL = %q, want %q", db.sql, want)
+	}
+	if len(rows) != 1 || rows[int64(1)]["name"] != "Ann" {
+		t.Errorf("Unexpected rows: %v", rows)
+	}
+
+	db.rows = []any{"not a row"}
+	if _, err := NewPgxAdapter(db).LoadRows(context.Background(), "users", "id", []any{1}, nil); err == nil {
+		t.Error("Expected error for a non-object row")
+	}
+}
+
+type fakeSQLX struct{}
+
+func (fakeSQLX) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return nil
+}
+
+func TestSQLXAdapter_LoadRowsNeedsQueryContext(t *testing.T) {
+	_, err := NewSQLXAdapter(fakeSQLX{}).LoadRows(context.Background(), "users", "id", []any{1}, nil)
+	if !errors.Is(err, ErrLoadNotSupported) {
+		t.Errorf("Expected ErrLoadNotSupported, got %v", err)
+	}
+}
+
+func TestPgxArray(t *testing.T) {
+	tests := []struct {
+		values []any
+		want   any
+	}{
+		{[]any{"a", "b"}, []string{"a", "b"}},
+		{[]any{float64(1), 2, int64(3)}, []int64{1, 2, 3}},
+		{[]any{1.5, float64(2)}, []float64{1.5, 2}},
+		{[]any{"a", 1}, []any{"a", 1}},
+	}
+
+	for _, tt := range tests {
+		if got := pgxArray(tt.values); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("pgxArray(%v) = %#v, want %#v", tt.values, got, tt.want)
+		}
+	}
+}
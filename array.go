@@ -20,6 +20,8 @@ type ArrayValidator struct {
 	element        Validator // Validator for each element
 	unique         bool      // All elements must be unique
 	exists         *ExistsRule
+	uniqueInDB     *UniqueRule
+	dbSummary      bool // Report DB failures once on the array path
 	customFn       func(value []any, lookup Lookup) error
 	messages       map[string]MessageArg
 	nullable       bool
@@ -144,6 +146,46 @@ func (v *ArrayValidator) Exists(table, column string, wheres ...WhereClause) *Ar
 	return v
 }
 
+// ExistsWithMessage adds database existence check for each element with custom message
+func (v *ArrayValidator) ExistsWithMessage(message MessageArg, table, column string, wheres ...WhereClause) *ArrayValidator {
+	v.exists = &ExistsRule{Table: table, Column: column, Where: wheres}
+	v.messages["exists"] = message
+	return v
+}
+
+// UniqueInDB requires that no element already exists in the database
+func (v *ArrayValidator) UniqueInDB(table, column string, wheres ...WhereClause) *ArrayValidator {
+	v.uniqueInDB = &UniqueRule{Table: table, Column: column, Where: wheres}
+	return v
+}
+
+// UniqueInDBWithMessage is UniqueInDB with custom message
+func (v *ArrayValidator) UniqueInDBWithMessage(message MessageArg, table, column string, wheres ...WhereClause) *ArrayValidator {
+	v.uniqueInDB = &UniqueRule{Table: table, Column: column, Where: wheres}
+	v.messages["uniqueInDB"] = message
+	return v
+}
+
+// SummarizeDBErrors reports Exists/UniqueInDB failures as one error on the
+// array (e.g., "all 5 tag_ids must exist") instead of one per index. In
+// custom messages, Value holds the failing values and Param the array length.
+func (v *ArrayValidator) SummarizeDBErrors() *ArrayValidator {
+	v.dbSummary = true
+	return v
+}
+
+// Unscoped exempts the Exists and UniqueInDB rules defined before it from
+// Options.DBScope
+func (v *ArrayValidator) Unscoped() *ArrayValidator {
+	if v.exists != nil {
+		v.exists.Unscoped = true
+	}
+	if v.uniqueInDB != nil {
+		v.uniqueInDB.Unscoped = true
+	}
+	return v
+}
+
 // Custom adds custom validation function
 func (v *ArrayValidator) Custom(fn func(value []any, lookup Lookup) error) *ArrayValidator {
 	v.customFn = fn
@@ -190,11 +232,13 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 		Index: extractIndex(fieldPath),
 		Value: value,
 		Data:  DataAccessor(ctx.RootData),
+		rec:   ctx.rec,
 	}
 
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.required {
@@ -209,6 +253,7 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		}
+		ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 		return nil
 	}
 
@@ -222,6 +267,8 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 	length := len(arr)
 	msgCtx.Value = arr
 
+	ctx.enterRules()
+
 	// Length check
 	if v.lengthSet && length != v.length {
 		msgCtx.Param = v.length
@@ -252,6 +299,7 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 					Index: i,
 					Value: item,
 					Data:  DataAccessor(ctx.RootData),
+					rec:   ctx.rec,
 				}
 				errors[elementPath] = append(errors[elementPath], v.msg("unique", fmt.Sprintf("%s[%d] is a duplicate", fieldName, i), elemCtx))
 			}
@@ -289,6 +337,7 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 						Value: item,
 						Param: forbidden,
 						Data:  DataAccessor(ctx.RootData),
+						rec:   ctx.rec,
 					}
 					errors[elementPath] = append(errors[elementPath], v.msg("doesntContain", fmt.Sprintf("%s must not contain %v", fieldName, forbidden), elemCtx))
 				}
@@ -316,8 +365,9 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 						RootData: ctx.RootData,
 						Path:     append(append([]string{}, ctx.Path...), fmt.Sprintf("%d", idx)),
 						Options:  ctx.Options,
+						reports:  ctx.reports,
 					}
-					childErrors := v.element.Validate(childCtx, val)
+					childErrors := validateField(childCtx, v.element, val)
 					if len(childErrors) > 0 {
 						mu.Lock()
 						for path, errs := range childErrors {
@@ -336,8 +386,9 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 					RootData: ctx.RootData,
 					Path:     append(ctx.Path, fmt.Sprintf("%d", i)),
 					Options:  ctx.Options,
+					reports:  ctx.reports,
 				}
-				childErrors := v.element.Validate(childCtx, item)
+				childErrors := validateField(childCtx, v.element, item)
 				// Merge child errors
 				for path, errs := range childErrors {
 					errors[path] = append(errors[path], errs...)
@@ -351,7 +402,7 @@ func (v *ArrayValidator) Validate(ctx *ValidationContext, value any) map[string]
 		lookup := func(path string) LookupResult {
 			return lookupPath(ctx.RootData, path)
 		}
-		if err := v.customFn(arr, lookup); err != nil {
+		if err := ctx.runCustom(func() error { return v.customFn(arr, lookup) }); err != nil {
 			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error(), msgCtx))
 		}
 	}
@@ -371,14 +422,37 @@ func (v *ArrayValidator) GetDBChecks(fieldPath string, value any) []DBCheck {
 		return nil
 	}
 
+	var summary string
+	if v.dbSummary {
+		summary = fieldPath
+	}
+
 	// If array has Exists rule, check each element
 	if v.exists != nil {
 		for i, item := range arr {
 			checks = append(checks, DBCheck{
-				Field:    fmt.Sprintf("%s.%d", fieldPath, i),
-				Value:    item,
-				Rule:     *v.exists,
-				IsUnique: false,
+				Field:        fmt.Sprintf("%s.%d", fieldPath, i),
+				Value:        item,
+				Rule:         *v.exists,
+				IsUnique:     false,
+				Message:      v.messages["exists"],
+				SummaryPath:  summary,
+				SummaryTotal: len(arr),
+			})
+		}
+	}
+
+	// If array has UniqueInDB rule, no element may exist
+	if v.uniqueInDB != nil {
+		for i, item := range arr {
+			checks = append(checks, DBCheck{
+				Field:        fmt.Sprintf("%s.%d", fieldPath, i),
+				Value:        item,
+				Rule:         ExistsRule{Table: v.uniqueInDB.Table, Column: v.uniqueInDB.Column, Where: v.uniqueInDB.Where, Unscoped: v.uniqueInDB.Unscoped},
+				IsUnique:     true,
+				Message:      v.messages["uniqueInDB"],
+				SummaryPath:  summary,
+				SummaryTotal: len(arr),
 			})
 		}
 	}
@@ -398,11 +472,60 @@ func (v *ArrayValidator) GetDBChecks(fieldPath string, value any) []DBCheck {
 }
 
 func (v *ArrayValidator) msg(rule, defaultMsg string, msgCtx MessageContext) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
 		msgCtx.Rule = rule
-		return resolveMessage(msg, msgCtx)
+		message = resolveMessage(msg, msgCtx)
+	}
+	msgCtx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain.
+// Element rules are traced on the element paths.
+func (v *ArrayValidator) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	rules = append(rules, ruleDesc{name: "type", param: "array"})
+	if v.lengthSet {
+		rules = append(rules, ruleDesc{name: "length", param: v.length})
+	}
+	if v.minSet {
+		rules = append(rules, ruleDesc{name: "min", param: v.min})
+	}
+	if v.maxSet {
+		rules = append(rules, ruleDesc{name: "max", param: v.max})
+	}
+	if v.unique {
+		rules = append(rules, ruleDesc{name: "unique"})
+	}
+	if len(v.contains) > 0 {
+		rules = append(rules, ruleDesc{name: "contains", param: v.contains})
+	}
+	if len(v.doesntContain) > 0 {
+		rules = append(rules, ruleDesc{name: "doesntContain", param: v.doesntContain})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	if v.exists != nil {
+		rules = append(rules, ruleDesc{name: "exists", param: v.exists.Table + "." + v.exists.Column, db: true})
+	}
+	if v.uniqueInDB != nil {
+		rules = append(rules, ruleDesc{name: "unique", label: "uniqueInDB", param: v.uniqueInDB.Table + "." + v.uniqueInDB.Column, db: true})
+	}
+	return rules
+}
+
+// Describe returns a read-only description of the configured rules, with the
+// element validator in Element
+func (v *ArrayValidator) Describe() Description {
+	d := newDescription("array", v.nullable, v.describeRules(), v.messages)
+	d.DB = describeDBRules(v.exists, v.uniqueInDB)
+	if v.element != nil {
+		element := describeValidator(v.element)
+		d.Element = &element
 	}
-	return defaultMsg
+	return d
 }
 
 // equalValues compares two values for equality using reflect.DeepEqual
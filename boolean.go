@@ -111,11 +111,13 @@ func (v *BoolValidator) Validate(ctx *ValidationContext, value any) map[string][
 		Index: extractIndex(fieldPath),
 		Value: value,
 		Data:  DataAccessor(ctx.RootData),
+		rec:   ctx.rec,
 	}
 
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.defaultValue != nil {
@@ -130,6 +132,7 @@ func (v *BoolValidator) Validate(ctx *ValidationContext, value any) map[string][
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		} else {
+			ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 			return nil
 		}
 	}
@@ -148,6 +151,8 @@ func (v *BoolValidator) Validate(ctx *ValidationContext, value any) map[string][
 
 	msgCtx.Value = b
 
+	ctx.enterRules()
+
 	// Must be true
 	if v.mustBeTrue && !b {
 		errors[fieldPath] = append(errors[fieldPath], v.msg("true", fmt.Sprintf("%s must be true", fieldName), msgCtx))
@@ -163,7 +168,7 @@ func (v *BoolValidator) Validate(ctx *ValidationContext, value any) map[string][
 		lookup := func(path string) LookupResult {
 			return lookupPath(ctx.RootData, path)
 		}
-		if err := v.customFn(b, lookup); err != nil {
+		if err := ctx.runCustom(func() error { return v.customFn(b, lookup) }); err != nil {
 			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error(), msgCtx))
 		}
 	}
@@ -175,11 +180,40 @@ func (v *BoolValidator) Validate(ctx *ValidationContext, value any) map[string][
 }
 
 func (v *BoolValidator) msg(rule, defaultMsg string, msgCtx MessageContext) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
 		msgCtx.Rule = rule
-		return resolveMessage(msg, msgCtx)
+		message = resolveMessage(msg, msgCtx)
+	}
+	msgCtx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *BoolValidator) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	if v.defaultValue != nil {
+		rules = append(rules, ruleDesc{name: "default", param: *v.defaultValue, whenNil: true})
+	}
+	if v.coerce {
+		rules = append(rules, ruleDesc{name: "coerce", transform: true})
 	}
-	return defaultMsg
+	rules = append(rules, ruleDesc{name: "type", param: "boolean"})
+	if v.mustBeTrue {
+		rules = append(rules, ruleDesc{name: "true"})
+	}
+	if v.mustBeFalse {
+		rules = append(rules, ruleDesc{name: "false"})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	return rules
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *BoolValidator) Describe() Description {
+	return newDescription("boolean", v.nullable, v.describeRules(), v.messages)
 }
 
 func coerceToBool(value any) any {
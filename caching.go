@@ -0,0 +1,385 @@
+package valet
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// uniqueCheckKey marks contexts of CheckExists calls made for Unique rules
+type uniqueCheckKey struct{}
+
+// withUniqueCheck marks ctx as belonging to a Unique check
+func withUniqueCheck(ctx context.Context) context.Context {
+	return context.WithValue(ctx, uniqueCheckKey{}, true)
+}
+
+// IsUniqueCheck reports whether a CheckExists call is made for a Unique rule.
+// Wrapping checkers can use it to skip caching, since uniqueness must always
+// be checked against the current state of the database.
+func IsUniqueCheck(ctx context.Context) bool {
+	unique, _ := ctx.Value(uniqueCheckKey{}).(bool)
+	return unique
+}
+
+// CacheStats holds CachingChecker counters
+type CacheStats struct {
+	Hits    int64 // Values answered from the cache
+	Misses  int64 // Values queried from the wrapped checker
+	Shared  int64 // Values answered by a concurrent identical query
+	Bypass  int64 // Calls passed through uncached (Unique checks)
+	Entries int   // Current number of cached values
+}
+
+// CachingChecker wraps a DBChecker and caches per value results keyed by
+// table, column and where clauses. Concurrent lookups of the same value share
+// one query (if that caller's context ends first, the others query
+// themselves), and Unique checks always go to the wrapped checker.
+type CachingChecker struct {
+	checker     DBChecker
+	ttl         time.Duration
+	negativeTTL time.Duration
+	maxEntries  int
+	now         func() time.Time
+
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+	inflight map[string]*cacheCall
+
+	hits   atomic.Int64
+	misses atomic.Int64
+	shared atomic.Int64
+	bypass atomic.Int64
+}
+
+// cacheEntry is a cached result for a single value
+type cacheEntry struct {
+	key     string
+	table   string
+	column  string
+	value   any
+	exists  bool
+	expires time.Time
+}
+
+// cacheCall is an in-flight query for a single value
+type cacheCall struct {
+	done   chan struct{}
+	exists bool
+	err    error
+	// abandoned is set when the owner's context ended or the wrapped checker
+	// panicked; waiters then query the value themselves
+	abandoned bool
+}
+
+// NewCachingChecker wraps checker with a cache of 10000 values for one minute
+func NewCachingChecker(checker DBChecker) *CachingChecker {
+	return &CachingChecker{
+		checker:     checker,
+		ttl:         time.Minute,
+		negativeTTL: time.Minute,
+		maxEntries:  10000,
+		now:         time.Now,
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		inflight:    make(map[string]*cacheCall),
+	}
+}
+
+// WithTTL sets how long results are cached; it also sets the negative TTL
+func (c *CachingChecker) WithTTL(ttl time.Duration) *CachingChecker {
+	c.ttl = ttl
+	c.negativeTTL = ttl
+	return c
+}
+
+// WithNegativeTTL sets how long "does not exist" results are cached;
+// zero disables caching of missing values
+func (c *CachingChecker) WithNegativeTTL(ttl time.Duration) *CachingChecker {
+	c.negativeTTL = ttl
+	return c
+}
+
+// WithMaxEntries bounds the number of cached values; the least recently used
+// values are evicted first
+func (c *CachingChecker) WithMaxEntries(n int) *CachingChecker {
+	c.maxEntries = n
+	return c
+}
+
+// CheckExists implements DBChecker
+func (c *CachingChecker) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	if IsUniqueCheck(ctx) {
+		c.bypass.Add(1)
+		return c.checker.CheckExists(ctx, table, column, values, wheres)
+	}
+
+	prefix := makeBatchKey(table, column, wheres) + "\x00"
+	keys := make([]string, len(values))
+	found := make(map[string]bool, len(values))
+	now := c.now()
+
+	var (
+		owned     []*cacheCall
+		ownedKeys []string
+		queryVals []any
+		waiting   []*cacheCall
+		waitKeys  []string
+		waitVals  []any
+	)
+	seen := make(map[string]bool, len(values))
+
+	c.mu.Lock()
+	for i, v := range values {
+		key := prefix + cacheValueKey(v)
+		keys[i] = key
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if el, ok := c.entries[key]; ok {
+			entry := el.Value.(*cacheEntry)
+			if now.Before(entry.expires) {
+				c.lru.MoveToFront(el)
+				c.hits.Add(1)
+				found[key] = entry.exists
+				continue
+			}
+			c.removeElement(el)
+		}
+
+		if call, ok := c.inflight[key]; ok {
+			c.shared.Add(1)
+			waiting = append(waiting, call)
+			waitKeys = append(waitKeys, key)
+			waitVals = append(waitVals, v)
+			continue
+		}
+
+		call := &cacheCall{done: make(chan struct{})}
+		c.inflight[key] = call
+		c.misses.Add(1)
+		owned = append(owned, call)
+		ownedKeys = append(ownedKeys, key)
+		queryVals = append(queryVals, v)
+	}
+	c.mu.Unlock()
+
+	if len(owned) > 0 {
+		if err := c.queryOwned(ctx, table, column, wheres, owned, ownedKeys, queryVals); err != nil {
+			return nil, err
+		}
+		for i, call := range owned {
+			found[ownedKeys[i]] = call.exists
+		}
+	}
+
+	var retry []any
+	for i, call := range waiting {
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		switch {
+		case call.abandoned:
+			retry = append(retry, waitVals[i])
+		case call.err != nil:
+			return nil, call.err
+		default:
+			found[waitKeys[i]] = call.exists
+		}
+	}
+	if len(retry) > 0 {
+		rows, err := c.CheckExists(ctx, table, column, retry, wheres)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range retry {
+			found[prefix+cacheValueKey(v)] = rows[v]
+		}
+	}
+
+	result := make(map[any]bool, len(values))
+	for i, v := range values {
+		if found[keys[i]] {
+			result[v] = true
+		}
+	}
+	return result, nil
+}
+
+// queryOwned queries the values this call owns, caches the results and
+// releases their waiters. The owner's context errors and panics of the wrapped
+// checker are not shared: the calls are marked abandoned so waiters with a
+// live context query themselves.
+func (c *CachingChecker) queryOwned(ctx context.Context, table, column string, wheres []WhereClause, calls []*cacheCall, keys []string, values []any) (err error) {
+	abandoned := true
+	defer func() {
+		expiresAt := c.now()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i, call := range calls {
+			delete(c.inflight, keys[i])
+			switch {
+			case abandoned:
+				call.abandoned = true
+			case err != nil:
+				call.err = err
+			default:
+				c.store(keys[i], table, column, values[i], call.exists, expiresAt)
+			}
+			close(call.done)
+		}
+	}()
+
+	rows, err := c.checker.CheckExists(ctx, table, column, values, wheres)
+	if err != nil && ctx.Err() != nil {
+		return err
+	}
+	abandoned = false
+	if err != nil {
+		return err
+	}
+
+	normalized := make(map[any]bool, len(rows))
+	for k, ok := range rows {
+		if ok {
+			normalized[NormalizeDBKey(k)] = true
+		}
+	}
+	for i, call := range calls {
+		call.exists = normalized[NormalizeDBKey(values[i])]
+	}
+	return nil
+}
+
+// LoadRows implements DBLoader by passing through to the wrapped checker
+// uncached; rows are expected to be fresh
+func (c *CachingChecker) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	loader, ok := c.checker.(DBLoader)
+	if !ok {
+		return nil, ErrLoadNotSupported
+	}
+	c.bypass.Add(1)
+	return loader.LoadRows(ctx, table, column, values, wheres)
+}
+
+// Dialect implements RawQuerier for the wrapped checker
+func (c *CachingChecker) Dialect() Dialect {
+	if querier, ok := c.checker.(RawQuerier); ok {
+		return querier.Dialect()
+	}
+	return DefaultDialect
+}
+
+// QueryColumn implements RawQuerier by passing through to the wrapped checker
+// uncached
+func (c *CachingChecker) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	querier, ok := c.checker.(RawQuerier)
+	if !ok {
+		return nil, ErrRawQueryNotSupported
+	}
+	c.bypass.Add(1)
+	return querier.QueryColumn(ctx, query, args...)
+}
+
+// store caches a value, evicting the least recently used entries over the bound.
+// Must be called with c.mu held.
+func (c *CachingChecker) store(key, table, column string, value any, exists bool, now time.Time) {
+	ttl := c.ttl
+	if !exists {
+		ttl = c.negativeTTL
+	}
+	if ttl <= 0 || c.maxEntries <= 0 {
+		return
+	}
+
+	entry := &cacheEntry{
+		key:     key,
+		table:   table,
+		column:  column,
+		value:   NormalizeDBKey(value),
+		exists:  exists,
+		expires: now.Add(ttl),
+	}
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+
+	for c.lru.Len() > c.maxEntries {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+// removeElement drops a cache entry. Must be called with c.mu held.
+func (c *CachingChecker) removeElement(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).key)
+}
+
+// Invalidate drops all cached values for a table
+func (c *CachingChecker) Invalidate(table string) {
+	c.invalidate(func(e *cacheEntry) bool { return e.table == table })
+}
+
+// InvalidateValues drops cached results for specific values of table.column,
+// e.g. after inserting or deleting those rows
+func (c *CachingChecker) InvalidateValues(table, column string, values ...any) {
+	keys := make(map[any]bool, len(values))
+	for _, v := range values {
+		keys[NormalizeDBKey(v)] = true
+	}
+	c.invalidate(func(e *cacheEntry) bool {
+		return e.table == table && e.column == column && keys[e.value]
+	})
+}
+
+// Purge drops every cached value
+func (c *CachingChecker) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+func (c *CachingChecker) invalidate(match func(*cacheEntry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if match(el.Value.(*cacheEntry)) {
+			c.removeElement(el)
+		}
+		el = next
+	}
+}
+
+// Stats returns the cache counters
+func (c *CachingChecker) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.lru.Len()
+	c.mu.Unlock()
+
+	return CacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Shared:  c.shared.Load(),
+		Bypass:  c.bypass.Load(),
+		Entries: entries,
+	}
+}
+
+// cacheValueKey returns a string key for a normalized value
+func cacheValueKey(value any) string {
+	key := NormalizeDBKey(value)
+	return fmt.Sprintf("%T:%v", key, key)
+}
@@ -0,0 +1,349 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingChecker records calls and the values each call queried
+type countingChecker struct {
+	mu       sync.Mutex
+	existing map[any]bool
+	calls    [][]any
+	unique   []bool
+	err      error
+	block    chan struct{}
+}
+
+func (c *countingChecker) CheckExists(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	c.mu.Lock()
+	c.calls = append(c.calls, values)
+	c.unique = append(c.unique, IsUniqueCheck(ctx))
+	c.mu.Unlock()
+
+	if c.block != nil {
+		<-c.block
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	result := make(map[any]bool)
+	for _, v := range values {
+		if c.existing[NormalizeDBKey(v)] {
+			result[v] = true
+		}
+	}
+	return result, nil
+}
+
+func (c *countingChecker) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.calls)
+}
+
+func TestCachingChecker_HitsAndMisses(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{"us": true, "de": true}}
+	cache := NewCachingChecker(inner)
+	ctx := context.Background()
+
+	result, err := cache.CheckExists(ctx, "countries", "code", []any{"us", "xx"}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result["us"] || result["xx"] {
+		t.Errorf("Unexpected result: %v", result)
+	}
+
+	result, _ = cache.CheckExists(ctx, "countries", "code", []any{"us", "xx", "de"}, nil)
+	if !result["us"] || result["xx"] || !result["de"] {
+		t.Errorf("Unexpected result: %v", result)
+	}
+
+	if inner.callCount() != 2 {
+		t.Fatalf("Expected 2 queries, got %d", inner.callCount())
+	}
+	if got := inner.calls[1]; len(got) != 1 || got[0] != "de" {
+		t.Errorf("Second query should only ask for uncached values, got %v", got)
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 2 || stats.Misses != 3 || stats.Entries != 3 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+func TestCachingChecker_KeysIncludeWheresAndNormalizedValues(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{int64(5): true}}
+	cache := NewCachingChecker(inner)
+	ctx := context.Background()
+
+	_, _ = cache.CheckExists(ctx, "plans", "id", []any{float64(5)}, nil)
+	result, _ := cache.CheckExists(ctx, "plans", "id", []any{int64(5), "5"}, nil)
+	if !result[int64(5)] || !result["5"] {
+		t.Errorf("Expected normalized hits, got %v", result)
+	}
+	if inner.callCount() != 1 {
+		t.Errorf("Expected 1 query, got %d", inner.callCount())
+	}
+
+	_, _ = cache.CheckExists(ctx, "plans", "id", []any{float64(5)}, []WhereClause{WhereEq("active", true)})
+	if inner.callCount() != 2 {
+		t.Errorf("Different where clauses must not share cache entries, got %d queries", inner.callCount())
+	}
+}
+
+func TestCachingChecker_TTL(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{"usd": true}}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCachingChecker(inner).WithTTL(time.Minute).WithNegativeTTL(0)
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, _ = cache.CheckExists(ctx, "currencies", "code", []any{"usd", "xyz"}, nil)
+	if cache.Stats().Entries != 1 {
+		t.Errorf("Negative results should not be cached with zero negative TTL")
+	}
+
+	now = now.Add(30 * time.Second)
+	_, _ = cache.CheckExists(ctx, "currencies", "code", []any{"usd"}, nil)
+	if inner.callCount() != 1 {
+		t.Errorf("Expected cached result within TTL, got %d queries", inner.callCount())
+	}
+
+	now = now.Add(time.Minute)
+	_, _ = cache.CheckExists(ctx, "currencies", "code", []any{"usd"}, nil)
+	if inner.callCount() != 2 {
+		t.Errorf("Expected expired entry to be re-queried, got %d queries", inner.callCount())
+	}
+}
+
+func TestCachingChecker_MaxEntries(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{}}
+	cache := NewCachingChecker(inner).WithMaxEntries(2)
+	ctx := context.Background()
+
+	_, _ = cache.CheckExists(ctx, "t", "c", []any{"a", "b"}, nil)
+	_, _ = cache.CheckExists(ctx, "t", "c", []any{"a"}, nil) // a is now most recent
+	_, _ = cache.CheckExists(ctx, "t", "c", []any{"c"}, nil) // evicts b
+
+	if cache.Stats().Entries != 2 {
+		t.Errorf("Entries = %d, want 2", cache.Stats().Entries)
+	}
+
+	before := inner.callCount()
+	_, _ = cache.CheckExists(ctx, "t", "c", []any{"a"}, nil)
+	if inner.callCount() != before {
+		t.Error("Expected a to stay cached")
+	}
+	_, _ = cache.CheckExists(ctx, "t", "c", []any{"b"}, nil)
+	if inner.callCount() != before+1 {
+		t.Error("Expected b to be evicted")
+	}
+}
+
+func TestCachingChecker_InFlightDeduplication(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{"us": true}, block: make(chan struct{})}
+	cache := NewCachingChecker(inner)
+	ctx := context.Background()
+
+	const n = 10
+	var wg sync.WaitGroup
+	var found atomic.Int32
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := cache.CheckExists(ctx, "countries", "code", []any{"us"}, nil)
+			if err == nil && result["us"] {
+				found.Add(1)
+			}
+		}()
+	}
+
+	// Wait until every goroutine either owns or waits for the query
+	for {
+		stats := cache.Stats()
+		if stats.Misses+stats.Shared == n {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(inner.block)
+	wg.Wait()
+
+	if inner.callCount() != 1 {
+		t.Errorf("Expected 1 query, got %d", inner.callCount())
+	}
+	if found.Load() != n {
+		t.Errorf("Expected all %d callers to see the result, got %d", n, found.Load())
+	}
+	if stats := cache.Stats(); stats.Shared != n-1 {
+		t.Errorf("Shared = %d, want %d", stats.Shared, n-1)
+	}
+}
+
+// waitForStats waits until the cache has seen the given misses and shared lookups
+func waitForStats(t *testing.T, cache *CachingChecker, misses, shared int64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		stats := cache.Stats()
+		if stats.Misses == misses && stats.Shared == shared {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for stats, got %+v", stats)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCachingChecker_OwnerContextErrorNotShared(t *testing.T) {
+	var calls atomic.Int32
+	cache := NewCachingChecker(FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		if calls.Add(1) == 1 {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return map[any]bool{"us": true}, nil
+	}))
+
+	ownerCtx, cancel := context.WithCancel(context.Background())
+	ownerErr := make(chan error, 1)
+	go func() {
+		_, err := cache.CheckExists(ownerCtx, "countries", "code", []any{"us"}, nil)
+		ownerErr <- err
+	}()
+	waitForStats(t, cache, 1, 0)
+
+	waiterDone := make(chan struct{})
+	var result map[any]bool
+	var err error
+	go func() {
+		defer close(waiterDone)
+		result, err = cache.CheckExists(context.Background(), "countries", "code", []any{"us"}, nil)
+	}()
+	waitForStats(t, cache, 1, 1)
+	cancel()
+
+	if got := <-ownerErr; !errors.Is(got, context.Canceled) {
+		t.Errorf("Owner error = %v, want context.Canceled", got)
+	}
+	<-waiterDone
+	if err != nil || !result["us"] {
+		t.Errorf("Waiter should query itself, got %v %v", result, err)
+	}
+}
+
+func TestCachingChecker_PanicReleasesWaiters(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	cache := NewCachingChecker(FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		if calls.Add(1) == 1 {
+			<-release
+			panic("driver bug")
+		}
+		return map[any]bool{"us": true}, nil
+	}))
+
+	ownerDone := make(chan any, 1)
+	go func() {
+		defer func() { ownerDone <- recover() }()
+		_, _ = cache.CheckExists(context.Background(), "countries", "code", []any{"us"}, nil)
+	}()
+	waitForStats(t, cache, 1, 0)
+
+	waiterDone := make(chan struct{})
+	var result map[any]bool
+	var err error
+	go func() {
+		defer close(waiterDone)
+		result, err = cache.CheckExists(context.Background(), "countries", "code", []any{"us"}, nil)
+	}()
+	waitForStats(t, cache, 1, 1)
+	close(release)
+
+	if p := <-ownerDone; p != "driver bug" {
+		t.Errorf("Expected the panic to reach the owner, got %v", p)
+	}
+	select {
+	case <-waiterDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Waiter blocked after the owner panicked")
+	}
+	if err != nil || !result["us"] {
+		t.Errorf("Waiter should query itself, got %v %v", result, err)
+	}
+}
+
+func TestCachingChecker_ErrorsAreNotCached(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{"us": true}, err: errors.New("down")}
+	cache := NewCachingChecker(inner)
+	ctx := context.Background()
+
+	if _, err := cache.CheckExists(ctx, "countries", "code", []any{"us"}, nil); err == nil {
+		t.Fatal("Expected error")
+	}
+
+	inner.err = nil
+	result, err := cache.CheckExists(ctx, "countries", "code", []any{"us"}, nil)
+	if err != nil || !result["us"] {
+		t.Errorf("Expected recovery after error, got %v %v", result, err)
+	}
+}
+
+func TestCachingChecker_Invalidate(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{}}
+	cache := NewCachingChecker(inner)
+	ctx := context.Background()
+
+	_, _ = cache.CheckExists(ctx, "countries", "code", []any{"us", "de"}, nil)
+	_, _ = cache.CheckExists(ctx, "plans", "id", []any{1}, nil)
+
+	cache.InvalidateValues("countries", "code", "us")
+	if cache.Stats().Entries != 2 {
+		t.Errorf("Entries = %d, want 2", cache.Stats().Entries)
+	}
+
+	cache.Invalidate("countries")
+	if cache.Stats().Entries != 1 {
+		t.Errorf("Entries = %d, want 1", cache.Stats().Entries)
+	}
+
+	cache.Purge()
+	if cache.Stats().Entries != 0 {
+		t.Errorf("Entries = %d, want 0", cache.Stats().Entries)
+	}
+}
+
+func TestCachingChecker_UniqueBypassesCache(t *testing.T) {
+	inner := &countingChecker{existing: map[any]bool{"a@example.com": true, "us": true}}
+	cache := NewCachingChecker(inner)
+
+	data := DataObject{"email": "a@example.com", "country": "us"}
+	schema := Schema{
+		"email":   String().Unique("users", "email", nil),
+		"country": String().Exists("countries", "code"),
+	}
+
+	for i := 0; i < 2; i++ {
+		verr := ValidateWithDB(context.Background(), data, schema, cache)
+		if verr == nil || len(verr.Errors["email"]) != 1 || verr.Errors["country"] != nil {
+			t.Fatalf("Unexpected result: %v", verr)
+		}
+	}
+
+	// 2 unique queries + 1 cached exists query
+	if inner.callCount() != 3 {
+		t.Errorf("Expected 3 queries, got %d", inner.callCount())
+	}
+	if stats := cache.Stats(); stats.Bypass != 2 || stats.Hits != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
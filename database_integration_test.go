@@ -2,7 +2,9 @@ package valet
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 )
@@ -339,6 +341,200 @@ func TestDBValidator_ArrayExists_SomeNotFound(t *testing.T) {
 	}
 }
 
+func TestDBValidator_CheckerError_FailClosed(t *testing.T) {
+	driverErr := errors.New("dial tcp 10.0.0.5:5432: connection refused")
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		return nil, driverErr
+	})
+
+	data := DataObject{"user_id": float64(1)}
+	schema := Schema{"user_id": Float().Required().Exists("users", "id")}
+
+	verr := Validate(data, schema, Options{DBChecker: checker})
+	if verr == nil {
+		t.Fatal("Expected validation to fail closed")
+	}
+	if len(verr.Errors) != 0 {
+		t.Errorf("Expected no field errors, got: %v", verr.Errors)
+	}
+	if !errors.Is(verr, ErrDBCheckFailed) || !errors.Is(verr, driverErr) {
+		t.Errorf("Expected DBError to wrap ErrDBCheckFailed and driver error, got: %v", verr.DBError)
+	}
+
+	var dbErr *DBCheckError
+	if !errors.As(verr.DBError, &dbErr) || dbErr.Table != "users" || dbErr.Column != "id" {
+		t.Fatalf("Expected *DBCheckError for users.id, got: %v", verr.DBError)
+	}
+	if len(dbErr.Fields) != 1 || dbErr.Fields[0] != "user_id" {
+		t.Errorf("Fields = %v, want [user_id]", dbErr.Fields)
+	}
+
+	_, err := ValidateWithDBContext(context.Background(), data, schema, Options{DBChecker: checker})
+	if !errors.Is(err, ErrDBCheckFailed) {
+		t.Fatalf("Expected ErrDBCheckFailed, got: %v", err)
+	}
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		t.Error("Expected infrastructure error, not *ValidationError")
+	}
+}
+
+func TestDBValidator_CheckerError_FailOpen(t *testing.T) {
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		return nil, errors.New("timeout")
+	})
+
+	data := DataObject{"user_id": float64(1)}
+	schema := Schema{"user_id": Float().Required().Exists("users", "id")}
+
+	if verr := Validate(data, schema, Options{DBChecker: checker, DBErrorPolicy: DBErrorFailOpen}); verr != nil {
+		t.Errorf("Expected no error with fail-open policy, got: %v %v", verr.Errors, verr.DBError)
+	}
+}
+
+func TestDBValidator_CheckerError_AsFieldErrors(t *testing.T) {
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		return nil, errors.New("pq: password authentication failed for user \"app\"")
+	})
+
+	data := DataObject{"user_id": float64(1)}
+	schema := Schema{"user_id": Float().Required().Exists("users", "id")}
+
+	verr := Validate(data, schema, Options{DBChecker: checker, DBErrorPolicy: DBErrorAsFieldErrors})
+	if verr == nil || len(verr.Errors["user_id"]) != 1 {
+		t.Fatalf("Expected one field error, got: %v", verr)
+	}
+	if msg := verr.Errors["user_id"][0]; msg != "user_id could not be verified" || strings.Contains(msg, "pq:") {
+		t.Errorf("Unexpected message: %q", msg)
+	}
+	if !errors.Is(verr, ErrDBCheckFailed) {
+		t.Error("Expected DBError to still be set")
+	}
+
+	_, err := ValidateWithDBContext(context.Background(), data, schema, Options{DBChecker: checker, DBErrorPolicy: DBErrorAsFieldErrors})
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("Expected *ValidationError, got: %v", err)
+	}
+
+	verr = Validate(data, schema, Options{
+		DBChecker:      checker,
+		DBErrorPolicy:  DBErrorAsFieldErrors,
+		DBErrorMessage: func(ctx MessageContext) string { return ctx.Rule + ":" + ctx.Table + "." + ctx.Column },
+	})
+	if got := verr.Errors["user_id"][0]; got != "database:users.id" {
+		t.Errorf("Custom message = %q, want database:users.id", got)
+	}
+}
+
+func TestDBValidator_MessageContext(t *testing.T) {
+	mock := NewMockDBChecker()
+
+	data := DataObject{
+		"tenant": "acme",
+		"items":  []any{map[string]any{"product_id": float64(7)}},
+	}
+	schema := Schema{
+		"tenant": String().Required(),
+		"items": Array().Of(Object().Shape(Schema{
+			"product_id": Float().ExistsWithMessage(func(ctx MessageContext) string {
+				return fmt.Sprintf("%s %s %s.%s %v %v %v", ctx.Rule, ctx.Field, ctx.Table, ctx.Column, ctx.Index, ctx.Value, ctx.Data.Get("tenant").String())
+			}, "products", "id"),
+		})),
+	}
+
+	verr := ValidateWithDB(context.Background(), data, schema, mock)
+	if verr == nil {
+		t.Fatal("Expected error")
+	}
+	want := "exists product_id products.id 0 7 acme"
+	if got := verr.Errors["items.0.product_id"]; len(got) != 1 || got[0] != want {
+		t.Errorf("Message = %v, want %q", got, want)
+	}
+}
+
+func TestDBValidator_DBCheckPerField(t *testing.T) {
+	mock := NewMockDBChecker()
+	mock.AddExisting("users", "email", "taken@example.com")
+
+	data := DataObject{
+		"name":  "",
+		"email": "taken@example.com",
+		"code":  "x",
+		"items": []any{
+			map[string]any{"product_id": float64(1), "quantity": float64(-1)},
+			map[string]any{"product_id": float64(2), "quantity": float64(1)},
+		},
+	}
+	schema := Schema{
+		"name":  String().Required(),
+		"email": String().Required().Email().Unique("users", "email", nil),
+		"code":  String().Min(3).Exists("codes", "code"),
+		"items": Array().Of(Object().Shape(Schema{
+			"product_id": Float().Exists("products", "id"),
+			"quantity":   Float().Positive(),
+		})),
+	}
+
+	// Default mode skips DB checks when any field failed
+	verr := Validate(data, schema, Options{DBChecker: mock})
+	if verr == nil || verr.Errors["email"] != nil {
+		t.Fatalf("Expected no DB errors in default mode, got: %v", verr)
+	}
+	if mock.QueryCount != 0 {
+		t.Errorf("Expected no queries in default mode, got %d", mock.QueryCount)
+	}
+
+	verr = Validate(data, schema, Options{DBChecker: mock, DBCheckMode: DBCheckPerField})
+	if verr == nil {
+		t.Fatal("Expected errors")
+	}
+	if len(verr.Errors["name"]) == 0 {
+		t.Error("Expected local error for name")
+	}
+	if len(verr.Errors["email"]) != 1 {
+		t.Errorf("Expected unique error for email, got: %v", verr.Errors["email"])
+	}
+	if len(verr.Errors["code"]) != 1 {
+		t.Errorf("Expected only the local error for code, got: %v", verr.Errors["code"])
+	}
+	// Sibling errors in the same object do not block the product check
+	if len(verr.Errors["items.0.product_id"]) != 1 || len(verr.Errors["items.1.product_id"]) != 1 {
+		t.Errorf("Expected exists errors for both products, got: %v", verr.Errors)
+	}
+	// users.email and products.id; codes.code is skipped
+	if mock.QueryCount != 2 {
+		t.Errorf("Expected 2 queries, got %d", mock.QueryCount)
+	}
+}
+
+func TestHasPathErrors(t *testing.T) {
+	errs := map[string][]string{
+		"items":         {"too many"},
+		"tags.1":        {"bad"},
+		"user.nickname": {"required"},
+	}
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"items", true},
+		{"items.0.product_id", true},
+		{"tags.1", true},
+		{"tags.0", false},
+		{"tags.10", false},
+		{"user.id", false},
+		{"user", false},
+	}
+
+	for _, tt := range tests {
+		if got := hasPathErrors(tt.path, errs); got != tt.want {
+			t.Errorf("hasPathErrors(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
 // ============================================================================
 // DB VALIDATION BENCHMARKS
 // ============================================================================
@@ -573,7 +769,7 @@ func BenchmarkQueryBuilding(b *testing.B) {
 			b.ReportAllocs()
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
-				buildExistsQuery("users", "id", values, nil)
+				_, _, _ = buildExistsQuery("users", "id", values, nil)
 			}
 		})
 	}
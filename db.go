@@ -1,20 +1,182 @@
 package valet
 
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
 // ExistsRule defines a database existence check
 type ExistsRule struct {
-	Table   string
-	Column  string
-	Where   []WhereClause
-	Message string
+	Table    string
+	Column   string
+	Where    []WhereClause
+	Message  string
+	Unscoped bool // Skip Options.DBScope clauses
+	Load     bool // Return the matched row (ExistsAndLoad)
+	// Query replaces the table/column lookup with a raw SQL template (ExistsQuery)
+	Query *RawQuery
+	// Func replaces the table/column lookup with a callback (ExistsFunc)
+	Func ExistsFunc
+
+	funcKey string // Identifies the rule so a Func batches across values
+}
+
+// newExistsFuncRule creates a callback rule with a unique batch key
+func newExistsFuncRule(fn ExistsFunc) *ExistsRule {
+	r := &ExistsRule{Func: fn}
+	r.funcKey = fmt.Sprintf("func:%p", r)
+	return r
+}
+
+// batchKey groups checks that can share a single query
+func (r *ExistsRule) batchKey() string {
+	switch {
+	case r.Func != nil:
+		return r.funcKey
+	case r.Query != nil:
+		return r.Query.key()
+	}
+	return makeBatchKey(r.Table, r.Column, r.Where)
 }
 
 // UniqueRule defines a database uniqueness check
 type UniqueRule struct {
-	Table   string
-	Column  string
-	Ignore  any // Value to ignore (for updates)
-	Where   []WhereClause
-	Message string
+	Table        string
+	Column       string
+	Ignore       any // Value to ignore (for updates)
+	IgnoreColumn string
+	IgnoreValue  any // Row to ignore by column, e.g. the record being updated
+	Where        []WhereClause
+	Message      string
+	Unscoped     bool // Skip Options.DBScope clauses
+}
+
+// queryWheres returns the rule's where clauses plus the ignore-by-column
+// clause, which is skipped when IgnoreValue is nil (e.g. on create)
+func (r *UniqueRule) queryWheres() []WhereClause {
+	if r.IgnoreColumn == "" || r.IgnoreValue == nil {
+		return r.Where
+	}
+	wheres := make([]WhereClause, 0, len(r.Where)+1)
+	wheres = append(wheres, r.Where...)
+	return append(wheres, WhereNot(r.IgnoreColumn, r.IgnoreValue))
+}
+
+// DBScope adds where clauses to every DB check, e.g. tenant_id = ? in a
+// multi-tenant app. Rules opt out with Unscoped().
+type DBScope struct {
+	// Where is merged into every check
+	Where []WhereClause
+	// Func returns extra clauses per table; nil or empty adds none
+	Func func(table string) []WhereClause
+	// Columns renames scope columns per table (table -> scope column ->
+	// table column); mapping to "" drops the clause for that table
+	Columns map[string]map[string]string
+}
+
+// clauses returns the scope clauses for a table with columns mapped
+func (s *DBScope) clauses(table string) []WhereClause {
+	wheres := s.Where
+	if s.Func != nil {
+		if extra := s.Func(table); len(extra) > 0 {
+			wheres = append(append([]WhereClause(nil), wheres...), extra...)
+		}
+	}
+
+	mapping := s.Columns[table]
+	if len(mapping) == 0 {
+		return wheres
+	}
+
+	mapped := make([]WhereClause, 0, len(wheres))
+	for _, w := range wheres {
+		if w, ok := mapScopeColumns(w, mapping); ok {
+			mapped = append(mapped, w)
+		}
+	}
+	return mapped
+}
+
+// mapScopeColumns renames the clause's columns; ok is false when it is dropped
+func mapScopeColumns(w WhereClause, mapping map[string]string) (WhereClause, bool) {
+	if len(w.Or) > 0 {
+		or := make([]WhereClause, 0, len(w.Or))
+		for _, sub := range w.Or {
+			if sub, ok := mapScopeColumns(sub, mapping); ok {
+				or = append(or, sub)
+			}
+		}
+		if len(or) == 0 {
+			return w, false
+		}
+		w.Or = or
+		return w, true
+	}
+
+	if column, ok := mapping[w.Column]; ok {
+		if column == "" {
+			return w, false
+		}
+		w.Column = column
+	}
+	return w, true
+}
+
+// active reports whether the scope adds any clauses
+func (s *DBScope) active() bool {
+	return s != nil && (len(s.Where) > 0 || s.Func != nil)
+}
+
+// dbScopeKey carries Options.DBScope in the context of DB checks
+type dbScopeKey struct{}
+
+// DBScopeWhere returns the Options.DBScope clauses for a table, with columns
+// mapped, from the context an ExistsFunc receives. Callbacks apply them
+// themselves and are marked Unscoped().
+func DBScopeWhere(ctx context.Context, table string) []WhereClause {
+	scope, _ := ctx.Value(dbScopeKey{}).(*DBScope)
+	if scope == nil {
+		return nil
+	}
+	return copyWheres(scope.clauses(table))
+}
+
+// applyDBScope appends the scope clauses to every check that is not
+// unscoped. valet cannot add clauses to raw queries and callbacks, so those
+// fail with ErrScopeNotApplied unless marked Unscoped(), rather than leaking
+// rows of other tenants.
+func applyDBScope(checks []DBCheck, scope *DBScope) error {
+	if !scope.active() {
+		return nil
+	}
+	var unscoped []string
+	cache := make(map[string][]WhereClause)
+	for i := range checks {
+		check := &checks[i]
+		if check.Rule.Unscoped {
+			continue
+		}
+		if check.Rule.Query != nil || check.Rule.Func != nil {
+			unscoped = append(unscoped, check.Field)
+			continue
+		}
+		scoped, ok := cache[check.Rule.Table]
+		if !ok {
+			scoped = scope.clauses(check.Rule.Table)
+			cache[check.Rule.Table] = scoped
+		}
+		if len(scoped) == 0 {
+			continue
+		}
+		wheres := make([]WhereClause, 0, len(check.Rule.Where)+len(scoped))
+		wheres = append(wheres, check.Rule.Where...)
+		check.Rule.Where = append(wheres, scoped...)
+	}
+	if len(unscoped) > 0 {
+		return &DBCheckError{Fields: unscoped, Err: ErrScopeNotApplied}
+	}
+	return nil
 }
 
 // DBCheck represents a pending database check
@@ -25,9 +187,61 @@ type DBCheck struct {
 	IsUnique bool
 	Ignore   any
 	Message  MessageArg
+	// SummaryPath reports failures once on this path (array summaries)
+	SummaryPath string
+	// SummaryTotal is the number of values in the summarized array
+	SummaryTotal int
 }
 
 // DBCheckCollector interface for validators that can collect DB checks
 type DBCheckCollector interface {
 	GetDBChecks(fieldPath string, value any) []DBCheck
 }
+
+// DBErrorPolicy controls how DBChecker failures affect validation
+type DBErrorPolicy int
+
+const (
+	// DBErrorFailClosed fails validation and reports the failure as a Go error
+	// (ValidationError.DBError, or the error returned by ValidateWithDBContext)
+	DBErrorFailClosed DBErrorPolicy = iota
+	// DBErrorFailOpen treats checks whose query failed as passed
+	DBErrorFailOpen
+	// DBErrorAsFieldErrors reports failures as field errors using a generic
+	// message (Options.DBErrorMessage) that does not expose driver details
+	DBErrorAsFieldErrors
+)
+
+// DBCheckMode controls when DB checks run relative to local validation
+type DBCheckMode int
+
+const (
+	// DBCheckWhenValid runs DB checks only when every field passed local validation
+	DBCheckWhenValid DBCheckMode = iota
+	// DBCheckPerField runs DB checks for every field whose own local rules (and
+	// those of its parents) passed, so DB errors surface alongside local ones
+	DBCheckPerField
+)
+
+// DBCheckError describes a failed batch query. It matches ErrDBCheckFailed
+// with errors.Is and unwraps to the underlying driver error.
+type DBCheckError struct {
+	Table  string
+	Column string
+	Fields []string
+	Err    error
+}
+
+func (e *DBCheckError) Error() string {
+	return ErrDBCheckFailed.Error() + " (" + e.Table + "." + e.Column + " for " + strings.Join(e.Fields, ", ") + "): " + e.Err.Error()
+}
+
+// Is reports whether target is ErrDBCheckFailed
+func (e *DBCheckError) Is(target error) bool {
+	return target == ErrDBCheckFailed
+}
+
+// Unwrap returns the underlying driver error
+func (e *DBCheckError) Unwrap() error {
+	return e.Err
+}
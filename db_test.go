@@ -1,6 +1,9 @@
 package valet
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -91,3 +94,324 @@ func TestDBCheck_Unique(t *testing.T) {
 		t.Errorf("Ignore = %v, want 123", check.Ignore)
 	}
 }
+
+func TestDBScope(t *testing.T) {
+	m := NewMemoryChecker(map[string][]map[string]any{
+		"users": {
+			{"id": 1, "email": "a@example.com", "tenant_id": 1},
+			{"id": 2, "email": "b@example.com", "tenant_id": 2},
+		},
+		"projects":  {{"id": 10, "org_id": 1}, {"id": 11, "org_id": 2}},
+		"countries": {{"code": "us"}},
+	})
+
+	scope := &DBScope{
+		Where:   []WhereClause{WhereEq("tenant_id", 1)},
+		Columns: map[string]map[string]string{"projects": {"tenant_id": "org_id"}},
+	}
+
+	schema := Schema{
+		"user_id":    Int().Exists("users", "id"),
+		"email":      String().Unique("users", "email", nil),
+		"project_id": Int().Exists("projects", "id"),
+		"country":    String().Exists("countries", "code").Unscoped(),
+	}
+
+	data := DataObject{"user_id": 2, "email": "b@example.com", "project_id": 11, "country": "us"}
+	verr := Validate(data, schema, Options{DBChecker: m, DBScope: scope})
+	if verr == nil {
+		t.Fatal("Expected errors for rows of another tenant")
+	}
+	if len(verr.Errors["user_id"]) != 1 || len(verr.Errors["project_id"]) != 1 {
+		t.Errorf("Expected user_id and project_id to be scoped, got %v", verr.Errors)
+	}
+	if verr.Errors["email"] != nil {
+		t.Errorf("Email of another tenant should be unique within the scope, got %v", verr.Errors["email"])
+	}
+	if verr.Errors["country"] != nil {
+		t.Errorf("Unscoped rule should not get tenant_id, got %v", verr.Errors["country"])
+	}
+
+	for _, q := range m.Queries() {
+		switch q.Table {
+		case "projects":
+			if len(q.Wheres) != 1 || q.Wheres[0].Column != "org_id" {
+				t.Errorf("Expected mapped org_id clause, got %v", q.Wheres)
+			}
+		case "countries":
+			if len(q.Wheres) != 0 {
+				t.Errorf("Expected no clauses for unscoped rule, got %v", q.Wheres)
+			}
+		}
+	}
+
+	data = DataObject{"user_id": 1, "email": "a@example.com", "project_id": 10, "country": "us"}
+	verr = Validate(data, schema, Options{DBChecker: m, DBScope: scope})
+	if verr == nil || len(verr.Errors) != 1 || len(verr.Errors["email"]) != 1 {
+		t.Errorf("Expected only the unique error within the tenant, got %v", verr)
+	}
+}
+
+func TestDBScope_Func(t *testing.T) {
+	scope := &DBScope{
+		Func: func(table string) []WhereClause {
+			if table == "countries" {
+				return nil
+			}
+			return []WhereClause{WhereEq("tenant_id", 7), WhereNull("deleted_at")}
+		},
+		Columns: map[string]map[string]string{"logs": {"deleted_at": ""}},
+	}
+
+	if got := scope.clauses("countries"); len(got) != 0 {
+		t.Errorf("Expected no clauses for countries, got %v", got)
+	}
+	if got := scope.clauses("users"); len(got) != 2 {
+		t.Errorf("Expected 2 clauses for users, got %v", got)
+	}
+	if got := scope.clauses("logs"); len(got) != 1 || got[0].Column != "tenant_id" {
+		t.Errorf("Expected deleted_at to be dropped for logs, got %v", got)
+	}
+
+	// The rule's own where slice must not be modified
+	// even when it has spare capacity shared by every check of the rule
+	own := make([]WhereClause, 1, 4)
+	own[0] = WhereEq("active", true)
+	checks := []DBCheck{
+		{Field: "a", Rule: ExistsRule{Table: "users", Column: "id", Where: own}},
+		{Field: "b", Rule: ExistsRule{Table: "logs", Column: "id", Where: own}},
+	}
+	applyDBScope(checks, scope)
+	if len(checks[0].Rule.Where) != 3 || len(checks[1].Rule.Where) != 2 {
+		t.Errorf("Unexpected where clauses: %v, %v", checks[0].Rule.Where, checks[1].Rule.Where)
+	}
+	if checks[0].Rule.Where[2].Column != "deleted_at" {
+		t.Errorf("Expected users to keep deleted_at, got %v", checks[0].Rule.Where)
+	}
+	if spare := own[:cap(own)]; spare[1].Column != "" || spare[2].Column != "" {
+		t.Errorf("Expected spare capacity of the rule's where slice to be untouched, got %v", spare)
+	}
+}
+
+func TestDBScope_RawRules(t *testing.T) {
+	db := &rawQuerier{dialect: SQLiteDialect{}, existing: map[any]bool{"SAVE10": true}}
+	scope := &DBScope{
+		Where:   []WhereClause{WhereEq("tenant_id", 1)},
+		Columns: map[string]map[string]string{"plans": {"tenant_id": "org_id"}},
+	}
+
+	// Raw queries and callbacks cannot be scoped, so they fail closed
+	schema := Schema{"coupon": String().ExistsQuery("SELECT code FROM coupons WHERE code IN ({values})")}
+	for _, policy := range []DBErrorPolicy{DBErrorFailClosed, DBErrorFailOpen} {
+		verr := Validate(DataObject{"coupon": "SAVE10"}, schema, Options{DBChecker: db, DBScope: scope, DBErrorPolicy: policy})
+		if verr == nil || !errors.Is(verr.DBError, ErrScopeNotApplied) || !errors.Is(verr.DBError, ErrDBCheckFailed) {
+			t.Errorf("policy %d: expected ErrScopeNotApplied, got %v", policy, verr)
+		}
+	}
+	if len(db.queries) != 0 {
+		t.Errorf("Expected no queries, got %v", db.queries)
+	}
+	plan := Plan(DataObject{"coupon": "SAVE10"}, schema, Options{DBChecker: db, DBScope: scope})
+	if len(plan.Batches) != 1 || !errors.Is(plan.Batches[0].Err, ErrScopeNotApplied) {
+		t.Errorf("Expected the planned batch to report ErrScopeNotApplied, got %+v", plan.Batches)
+	}
+
+	// Unscoped callbacks read the scope themselves
+	var got []WhereClause
+	schema = Schema{
+		"plan_id": Int().ExistsFunc(func(ctx context.Context, _ DBChecker, values []any) (map[any]bool, error) {
+			got = DBScopeWhere(ctx, "plans")
+			return map[any]bool{NormalizeDBKey(values[0]): true}, nil
+		}).Unscoped(),
+		"coupon": String().ExistsQuery("SELECT code FROM coupons WHERE code IN ({values}) AND tenant_id = ?", 1).Unscoped(),
+	}
+	if verr := Validate(DataObject{"plan_id": 3, "coupon": "SAVE10"}, schema, Options{DBChecker: db, DBScope: scope}); verr != nil {
+		t.Fatalf("Expected valid, got %v", verr)
+	}
+	if len(got) != 1 || got[0].Column != "org_id" || got[0].Value != 1 {
+		t.Errorf("Expected the mapped scope clause, got %v", got)
+	}
+	if DBScopeWhere(context.Background(), "plans") != nil {
+		t.Error("Expected no clauses without a scope")
+	}
+}
+
+func TestExistsAndLoad(t *testing.T) {
+	m := NewMemoryChecker(map[string][]map[string]any{
+		"users":    {{"id": 1, "name": "Ann"}, {"id": 2, "name": "Bob"}},
+		"products": {{"sku": "A-1", "price": 10}, {"sku": "B-2", "price": 20}},
+	})
+
+	data := DataObject{
+		"user_id": float64(1),
+		"items":   []any{map[string]any{"sku": "B-2"}, map[string]any{"sku": "A-1"}},
+	}
+	schema := Schema{
+		"user_id": Float().ExistsAndLoad("users", "id"),
+		"items": Array().Of(Object().Shape(Schema{
+			"sku": String().ExistsAndLoad("products", "sku"),
+		})),
+	}
+
+	result, verr := ValidateAndLoad(data, schema, Options{DBChecker: m})
+	if verr != nil {
+		t.Fatalf("Unexpected errors: %v", verr.Errors)
+	}
+
+	if row, ok := result.Row("user_id"); !ok || row["name"] != "Ann" {
+		t.Errorf("Expected Ann, got %v", row)
+	}
+	if row, _ := result.Row("items.0.sku"); row["price"] != 20 {
+		t.Errorf("Expected B-2 row, got %v", row)
+	}
+	if row, _ := result.Row("items.1.sku"); row["price"] != 10 {
+		t.Errorf("Expected A-1 row, got %v", row)
+	}
+	for _, q := range m.Queries() {
+		if !q.Load {
+			t.Errorf("Expected LoadRows for %s", q.Table)
+		}
+	}
+
+	// Missing rows still fail validation
+	data["user_id"] = float64(9)
+	if _, verr := ValidateAndLoad(data, schema, Options{DBChecker: m}); verr == nil || len(verr.Errors["user_id"]) != 1 {
+		t.Errorf("Expected exists error, got %v", verr)
+	}
+}
+
+func TestExistsAndLoad_RequiresLoader(t *testing.T) {
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		return map[any]bool{float64(1): true}, nil
+	})
+
+	_, verr := ValidateAndLoad(DataObject{"id": float64(1)}, Schema{"id": Float().ExistsAndLoad("users", "id")}, Options{DBChecker: checker})
+	if verr == nil || !errors.Is(verr, ErrLoadNotSupported) {
+		t.Fatalf("Expected ErrLoadNotSupported, got %v", verr)
+	}
+
+	// Plain Exists is unaffected
+	result, verr := ValidateAndLoad(DataObject{"id": float64(1)}, Schema{"id": Float().Exists("users", "id")}, Options{DBChecker: checker})
+	if verr != nil || len(result.Rows) != 0 {
+		t.Errorf("Unexpected result: %v %v", result, verr)
+	}
+
+	// Plain Exists sharing the batch group still runs through CheckExists
+	schema := Schema{
+		"id":    Float().ExistsAndLoad("users", "id"),
+		"owner": Float().Exists("users", "id"),
+	}
+	_, verr = ValidateAndLoad(DataObject{"id": float64(1), "owner": float64(2)}, schema, Options{DBChecker: checker, DBErrorPolicy: DBErrorAsFieldErrors})
+	if verr == nil || len(verr.Errors["id"]) != 1 || len(verr.Errors["owner"]) != 1 || verr.Errors["owner"][0] != "owner does not exist" {
+		t.Errorf("Expected load error for id and exists error for owner, got %v", verr)
+	}
+}
+
+// loadGorm is a GORM stand-in that scans canned rows
+type loadGorm struct {
+	sql  string
+	rows []map[string]any
+}
+
+func (g *loadGorm) Raw(ctx context.Context, sql string, values ...interface{}) GormResult {
+	g.sql = sql
+	return g
+}
+
+func (g *loadGorm) Scan(dest interface{}) error {
+	*dest.(*[]map[string]any) = g.rows
+	return nil
+}
+
+func TestGormAdapter_LoadRows(t *testing.T) {
+	db := &loadGorm{rows: []map[string]any{{"id": int64(1), "name": "Ann"}}}
+	adapter := NewGormAdapter(db).WithDialect(PostgresDialect{})
+
+	rows, err := adapter.LoadRows(context.Background(), "users", "id", []any{float64(1), float64(2)}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := `SELECT * FROM "users" WHERE "id" IN ($1,$2)`; db.sql != want {
+		t.Errorf("SQL = %q, want %q", db.sql, want)
+	}
+	if len(rows) != 1 || rows[int64(1)]["name"] != "Ann" {
+		t.Errorf("Unexpected rows: %v", rows)
+	}
+}
+
+func TestArrayValidator_ExistsMessageAndUniqueInDB(t *testing.T) {
+	checker := NewMemoryChecker(map[string][]map[string]any{
+		"tags": {{"id": 1}, {"id": 2}},
+	})
+
+	data := DataObject{
+		"tag_ids": []any{float64(1), float64(3)},
+		"new_ids": []any{float64(2), float64(4)},
+	}
+	schema := Schema{
+		"tag_ids": Array().ExistsWithMessage(func(ctx MessageContext) string {
+			return fmt.Sprintf("tag %v is unknown", ctx.Value)
+		}, "tags", "id"),
+		"new_ids": Array().UniqueInDB("tags", "id").Message("uniqueInDB", "tag taken"),
+	}
+
+	verr := ValidateWithDB(context.Background(), data, schema, checker)
+	if verr == nil {
+		t.Fatal("Expected errors")
+	}
+	if got := verr.Errors["tag_ids.1"]; len(got) != 1 || got[0] != "tag 3 is unknown" {
+		t.Errorf("tag_ids.1 = %v", got)
+	}
+	if got := verr.Errors["new_ids.0"]; len(got) != 1 || got[0] != "tag taken" {
+		t.Errorf("new_ids.0 = %v", got)
+	}
+	if len(verr.Errors) != 2 {
+		t.Errorf("Unexpected errors: %v", verr.Errors)
+	}
+}
+
+func TestArrayValidator_SummarizeDBErrors(t *testing.T) {
+	checker := NewMemoryChecker(map[string][]map[string]any{
+		"tags": {{"id": 1}, {"id": 2}},
+	})
+
+	data := DataObject{
+		"tag_ids": []any{float64(5), float64(1), float64(3)},
+		"new_ids": []any{float64(1), float64(2), float64(9)},
+	}
+	schema := Schema{
+		"tag_ids": Array().Exists("tags", "id").SummarizeDBErrors(),
+		"new_ids": Array().UniqueInDB("tags", "id").SummarizeDBErrors(),
+	}
+
+	verr := ValidateWithDB(context.Background(), data, schema, checker)
+	if verr == nil {
+		t.Fatal("Expected errors")
+	}
+	if got := verr.Errors["tag_ids"]; len(got) != 1 || got[0] != "all 3 tag_ids must exist" {
+		t.Errorf("tag_ids = %v", got)
+	}
+	if got := verr.Errors["new_ids"]; len(got) != 1 || got[0] != "2 of 3 new_ids already exist" {
+		t.Errorf("new_ids = %v", got)
+	}
+	if len(verr.Errors) != 2 {
+		t.Errorf("Expected no per-index errors, got %v", verr.Errors)
+	}
+
+	// Custom messages receive the failing values in index order
+	schema["tag_ids"] = Array().Exists("tags", "id").SummarizeDBErrors().
+		Message("exists", func(ctx MessageContext) string {
+			return fmt.Sprintf("%v of %v unknown", ctx.Value, ctx.Param)
+		})
+	verr = ValidateWithDB(context.Background(), data, schema, checker)
+	if got := verr.Errors["tag_ids"]; len(got) != 1 || got[0] != "[5 3] of 3 unknown" {
+		t.Errorf("tag_ids = %v", got)
+	}
+
+	// Under DBErrorAsFieldErrors a failed query is reported once per array
+	checker.FailWith("tags", errors.New("down"))
+	verr = Validate(data, schema, Options{DBChecker: checker, DBErrorPolicy: DBErrorAsFieldErrors})
+	if verr == nil || len(verr.Errors["tag_ids"]) != 1 || len(verr.Errors["new_ids"]) != 1 || len(verr.Errors) != 2 {
+		t.Errorf("Unexpected errors: %v", verr)
+	}
+}
@@ -0,0 +1,172 @@
+package valet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// decimalRegex matches plain decimal strings as returned for DECIMAL columns
+var decimalRegex = regexp.MustCompile(`^-?(0|[1-9][0-9]*)\.[0-9]+$`)
+
+// NormalizeDBKey converts payload values and database results into a canonical
+// comparable key so that, for example, JSON float64(5), a scanned int64(5) and
+// MySQL's []byte("5") all match. Adapters use it for result map keys; custom
+// DBChecker implementations should do the same.
+//
+// Rules:
+//   - integral numbers become int64 (uint64 above MaxInt64 stays uint64)
+//   - other floats become float64
+//   - []byte becomes string
+//   - strings in canonical integer or decimal form become numbers ("5" -> 5,
+//     "2.5" -> 2.5); others stay strings ("05", "5.00" and "1.10" are kept)
+//   - 16-byte arrays (UUID types) and UUID strings become lowercase hyphenated strings
+//   - time.Time becomes a UTC RFC3339Nano string
+func NormalizeDBKey(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return normalizeDBString(v)
+	case []byte:
+		return normalizeDBString(string(v))
+	case bool:
+		return v
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint:
+		return normalizeUint(uint64(v))
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		return normalizeUint(v)
+	case float32:
+		return normalizeFloat(float64(v))
+	case float64:
+		return normalizeFloat(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Array && rv.Len() == 16 && rv.Type().Elem().Kind() == reflect.Uint8 {
+		var b [16]byte
+		for i := 0; i < 16; i++ {
+			b[i] = byte(rv.Index(i).Uint())
+		}
+		return formatUUID(b)
+	}
+
+	if !rv.Type().Comparable() {
+		return stringerKey(value)
+	}
+	return value
+}
+
+// normalizeDBKeyFold normalizes a key and lowercases strings, matching
+// case-insensitive collations
+func normalizeDBKeyFold(value any, fold bool) any {
+	key := NormalizeDBKey(value)
+	if fold {
+		if s, ok := key.(string); ok {
+			return strings.ToLower(s)
+		}
+	}
+	return key
+}
+
+func normalizeUint(v uint64) any {
+	if v <= math.MaxInt64 {
+		return int64(v)
+	}
+	return v
+}
+
+func normalizeFloat(f float64) any {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f <= math.MaxInt64 {
+		return int64(f)
+	}
+	return f
+}
+
+func normalizeDBString(s string) any {
+	if s == "" {
+		return s
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil && strconv.FormatInt(n, 10) == s {
+		return n
+	}
+
+	// Only canonical decimals become numbers: "1.10" and "1.1" are different
+	// varchar values, so they must not share a key
+	if strings.ContainsRune(s, '.') && decimalRegex.MatchString(s) {
+		if f, err := strconv.ParseFloat(s, 64); err == nil && strconv.FormatFloat(f, 'f', -1, 64) == s {
+			return normalizeFloat(f)
+		}
+	}
+
+	if len(s) == 36 && isValidUUIDString(s) {
+		return strings.ToLower(s)
+	}
+
+	return s
+}
+
+// isValidUUIDString matches any hyphenated hex UUID regardless of version
+func isValidUUIDString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func formatUUID(b [16]byte) string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], b[10:])
+	return string(buf[:])
+}
+
+// stringerKey gives non-comparable values (which would panic as map keys) a
+// string representation
+func stringerKey(value any) any {
+	if s, ok := value.(interface{ String() string }); ok {
+		return normalizeDBString(s.String())
+	}
+	return fmt.Sprintf("%T:%v", value, value)
+}
@@ -0,0 +1,162 @@
+package valet
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNormalizeDBKey(t *testing.T) {
+	type uuidType [16]byte
+	id := uuidType{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4, 0xa7, 0x16, 0x44, 0x66, 0x55, 0x44, 0x00, 0x00}
+
+	tests := []struct {
+		name string
+		a, b any
+		same bool
+	}{
+		{"float64 vs int64", float64(5), int64(5), true},
+		{"float64 vs int", float64(5), 5, true},
+		{"float64 vs uint32", float64(5), uint32(5), true},
+		{"float64 vs bytes", float64(5), []byte("5"), true},
+		{"string vs int64", "5", int64(5), true},
+		{"fraction", float64(2.5), []byte("2.5"), true},
+		{"trailing zeros stay string", float64(5), []byte("5.00"), false},
+		{"distinct decimal strings", "1.1", "1.10", false},
+		{"canonical decimal string", "1.1", float64(1.1), true},
+		{"leading zero stays string", "05", int64(5), false},
+		{"bytes vs string", []byte("abc"), "abc", true},
+		{"uuid array vs string", id, "550E8400-E29B-41D4-A716-446655440000", true},
+		{"uuid bytes vs string", []byte("550e8400-e29b-41d4-a716-446655440000"), "550E8400-E29B-41D4-A716-446655440000", true},
+		{"different values", float64(5), int64(6), false},
+		{"case sensitive", "John@x.com", "john@x.com", false},
+		{"time", time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("X", 3600)), time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC), true},
+		{"bool", true, true, true},
+		{"nil", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NormalizeDBKey(tt.a), NormalizeDBKey(tt.b)
+			if (a == b) != tt.same {
+				t.Errorf("NormalizeDBKey(%v) = %#v, NormalizeDBKey(%v) = %#v, same = %v, want %v", tt.a, a, tt.b, b, a == b, tt.same)
+			}
+		})
+	}
+
+	t.Run("non-comparable values are usable as map keys", func(t *testing.T) {
+		m := map[any]bool{}
+		m[NormalizeDBKey([]int{1, 2})] = true
+		if len(m) != 1 {
+			t.Error("Expected key to be stored")
+		}
+	})
+}
+
+func TestDBKeyNormalization_Validation(t *testing.T) {
+	// Simulates a driver that returns int64 and []byte instead of float64/string
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		switch table {
+		case "categories":
+			return map[any]bool{int64(5): true}, nil
+		case "codes":
+			return map[any]bool{string([]byte("7")): true}, nil
+		case "users":
+			return map[any]bool{"john@example.com": true}, nil
+		}
+		return nil, nil
+	})
+
+	t.Run("numeric exists matches int64 result", func(t *testing.T) {
+		schema := Schema{"category_id": Float().Required().Exists("categories", "id")}
+		if err := ValidateWithDB(context.Background(), DataObject{"category_id": float64(5)}, schema, checker); err != nil {
+			t.Errorf("Expected no errors, got %v", err.Errors)
+		}
+	})
+
+	t.Run("numeric exists matches bytes result", func(t *testing.T) {
+		schema := Schema{"code": Float().Required().Exists("codes", "code")}
+		if err := ValidateWithDB(context.Background(), DataObject{"code": float64(7)}, schema, checker); err != nil {
+			t.Errorf("Expected no errors, got %v", err.Errors)
+		}
+	})
+
+	t.Run("unique ignore compares normalized values", func(t *testing.T) {
+		numChecker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+			return map[any]bool{int64(42): true}, nil
+		})
+		schema := Schema{"badge": Float().Required().Unique("users", "badge", 42)}
+		if err := ValidateWithDB(context.Background(), DataObject{"badge": float64(42)}, schema, numChecker); err != nil {
+			t.Errorf("Expected ignored value to pass, got %v", err.Errors)
+		}
+	})
+
+	t.Run("case-insensitive collation", func(t *testing.T) {
+		schema := Schema{"email": String().Required().Unique("users", "email", nil)}
+		data := DataObject{"email": "John@Example.com"}
+
+		if err := ValidateWithDB(context.Background(), data, schema, checker); err != nil {
+			t.Errorf("Case-sensitive matching should not find the row, got %v", err.Errors)
+		}
+
+		err := Validate(data, schema, Options{DBChecker: checker, DBCaseInsensitive: true})
+		if err == nil || len(err.Errors["email"]) == 0 {
+			t.Error("Expected unique error with case-insensitive matching")
+		}
+	})
+}
+
+func TestUniqueIgnoreWhere(t *testing.T) {
+	var captured []WhereClause
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		captured = wheres
+		for _, w := range wheres {
+			if w.Column == "id" && w.Operator == "!=" {
+				return map[any]bool{}, nil
+			}
+		}
+		return map[any]bool{"john@example.com": true}, nil
+	})
+
+	t.Run("update excludes current row", func(t *testing.T) {
+		schema := Schema{
+			"email": String().Required().Unique("users", "email", nil, WhereNull("deleted_at")).IgnoreWhere("id", 7),
+		}
+		if err := ValidateWithDB(context.Background(), DataObject{"email": "john@example.com"}, schema, checker); err != nil {
+			t.Errorf("Expected no errors, got %v", err.Errors)
+		}
+		if len(captured) != 2 || captured[1].Column != "id" || captured[1].Value != 7 {
+			t.Errorf("Expected ignore clause appended, got %+v", captured)
+		}
+	})
+
+	t.Run("nil ignore value applies no exclusion", func(t *testing.T) {
+		schema := Schema{
+			"email": String().Required().Unique("users", "email", nil).IgnoreWhere("id", nil),
+		}
+		err := ValidateWithDB(context.Background(), DataObject{"email": "john@example.com"}, schema, checker)
+		if err == nil {
+			t.Error("Expected unique error on create")
+		}
+		if len(captured) != 0 {
+			t.Errorf("Expected no where clauses, got %+v", captured)
+		}
+	})
+
+	t.Run("number validator", func(t *testing.T) {
+		schema := Schema{
+			"badge": Int().Required().Unique("users", "badge", nil).IgnoreWhere("id", int64(3)),
+		}
+		_ = ValidateWithDB(context.Background(), DataObject{"badge": float64(9)}, schema, checker)
+		if len(captured) != 1 || captured[0].Column != "id" {
+			t.Errorf("Expected ignore clause, got %+v", captured)
+		}
+	})
+
+	t.Run("without unique is a no-op", func(t *testing.T) {
+		v := String().IgnoreWhere("id", 1)
+		if v.unique != nil {
+			t.Error("IgnoreWhere should not create a unique rule")
+		}
+	})
+}
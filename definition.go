@@ -0,0 +1,1242 @@
+package valet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// validatorRegistry holds the validators registered with RegisterValidator
+var validatorRegistry = struct {
+	sync.RWMutex
+	byName map[string]Validator
+}{byName: make(map[string]Validator)}
+
+// RegisterValidator names a validator so declarative schemas can reference
+// it with {"ref": name}. Use it for validators whose rules are Go code
+// (Custom, RequiredIf, Transform, ExistsFunc, MessageFunc or custom
+// Validator types). Registering a name again replaces it.
+func RegisterValidator(name string, v Validator) {
+	validatorRegistry.Lock()
+	defer validatorRegistry.Unlock()
+	validatorRegistry.byName[name] = v
+}
+
+// registeredValidator returns a registered validator by name
+func registeredValidator(name string) (Validator, bool) {
+	validatorRegistry.RLock()
+	defer validatorRegistry.RUnlock()
+	v, ok := validatorRegistry.byName[name]
+	return v, ok
+}
+
+// registeredName returns the name a validator was registered under
+func registeredName(v Validator) (string, bool) {
+	if t := reflect.TypeOf(v); t == nil || !t.Comparable() {
+		return "", false
+	}
+	validatorRegistry.RLock()
+	defer validatorRegistry.RUnlock()
+	names := make([]string, 0, 1)
+	for name, registered := range validatorRegistry.byName {
+		if registered != nil && reflect.TypeOf(registered).Comparable() && registered == v {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	sort.Strings(names)
+	return names[0], true
+}
+
+// queryRegistry holds the raw queries registered with RegisterQuery
+var queryRegistry = struct {
+	sync.RWMutex
+	byName map[string]RawQuery
+}{byName: make(map[string]RawQuery)}
+
+// RegisterQuery names a raw SQL template (see ExistsQuery) so declarative
+// schemas can use it with {"rule": "exists", "query": name}. Schemas never
+// contain SQL themselves, so files edited outside the code cannot inject
+// queries. Registering a name again replaces it.
+func RegisterQuery(name, sql string, args ...any) {
+	queryRegistry.Lock()
+	defer queryRegistry.Unlock()
+	queryRegistry.byName[name] = RawQuery{SQL: sql, Args: args}
+}
+
+// registeredQuery returns a registered query by name
+func registeredQuery(name string) (RawQuery, bool) {
+	queryRegistry.RLock()
+	defer queryRegistry.RUnlock()
+	q, ok := queryRegistry.byName[name]
+	return q, ok
+}
+
+// registeredQueryName returns the name a query with the same SQL and args was
+// registered under
+func registeredQueryName(q RawQuery) (string, bool) {
+	queryRegistry.RLock()
+	defer queryRegistry.RUnlock()
+	names := make([]string, 0, 1)
+	for name, registered := range queryRegistry.byName {
+		sameArgs := len(registered.Args) == 0 && len(q.Args) == 0 || reflect.DeepEqual(registered.Args, q.Args)
+		if registered.SQL == q.SQL && sameArgs {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	sort.Strings(names)
+	return names[0], true
+}
+
+// fieldDef is the JSON form of a validator
+type fieldDef struct {
+	Type     string               `json:"type,omitempty"`
+	Ref      string               `json:"ref,omitempty"` // Registered validator name
+	Required bool                 `json:"required,omitempty"`
+	Nullable bool                 `json:"nullable,omitempty"`
+	Optional bool                 `json:"optional,omitempty"`
+	Default  any                  `json:"default,omitempty"`
+	Rules    map[string]any       `json:"rules,omitempty"` // Rule name to parameter (true for flags)
+	DB       []dbRuleDef          `json:"db,omitempty"`
+	Messages map[string]string    `json:"messages,omitempty"`
+	Fields   map[string]*fieldDef `json:"fields,omitempty"`
+	Element  *fieldDef            `json:"element,omitempty"`
+	Variants []*fieldDef          `json:"variants,omitempty"`
+
+	unknown []string // Keys that match no field, reported by build
+}
+
+func (def *fieldDef) UnmarshalJSON(data []byte) error {
+	type plain fieldDef
+	unknown, err := unmarshalKnown(data, (*plain)(def))
+	def.unknown = unknown
+	return err
+}
+
+// dbRuleDef is the JSON form of an Exists or Unique rule
+type dbRuleDef struct {
+	Rule     string     `json:"rule"`
+	Table    string     `json:"table,omitempty"`
+	Column   string     `json:"column,omitempty"`
+	Where    []whereDef `json:"where,omitempty"`
+	Query    string     `json:"query,omitempty"` // Registered query name
+	Load     bool       `json:"load,omitempty"`
+	Unscoped bool       `json:"unscoped,omitempty"`
+	Ignore   any        `json:"ignore,omitempty"`
+
+	unknown []string
+}
+
+func (db *dbRuleDef) UnmarshalJSON(data []byte) error {
+	type plain dbRuleDef
+	unknown, err := unmarshalKnown(data, (*plain)(db))
+	db.unknown = unknown
+	return err
+}
+
+// whereDef is the JSON form of a WhereClause
+type whereDef struct {
+	Column   string     `json:"column,omitempty"`
+	Operator string     `json:"operator"`
+	Value    any        `json:"value,omitempty"`
+	Field    string     `json:"field,omitempty"`
+	Or       []whereDef `json:"or,omitempty"`
+
+	unknown []string
+}
+
+func (w *whereDef) UnmarshalJSON(data []byte) error {
+	type plain whereDef
+	unknown, err := unmarshalKnown(data, (*plain)(w))
+	w.unknown = unknown
+	return err
+}
+
+// unmarshalKnown decodes a definition and returns its keys that match no
+// field. encoding/json would drop them (and match keys case-insensitively),
+// so a typo such as "requried" would quietly turn a constraint off.
+func unmarshalKnown(data []byte, v any) ([]string, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, err
+	}
+	t := reflect.TypeOf(v).Elem()
+	known := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		known[name] = name != ""
+	}
+	var unknown []string
+	for key := range keys {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown, nil
+}
+
+// unknownKeyError reports the first key that matches no field
+func unknownKeyError(unknown []string) error {
+	if len(unknown) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unknown key %q", unknown[0])
+}
+
+// LoadSchema reads a declarative JSON schema, as written by
+// Schema.MarshalJSON: an object of field names to definitions such as
+//
+//	{"email": {"type": "string", "required": true, "rules": {"email": true, "max": 100}}}
+//
+// Registered validators are referenced with {"ref": name}.
+func LoadSchema(r io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(r).Decode(&schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// MarshalJSON encodes the schema in the declarative format read by
+// LoadSchema. Rules that are Go code (Custom, RequiredIf, Transform,
+// ExistsFunc, MessageFunc) cannot be encoded; register those validators with
+// RegisterValidator to encode them as references.
+func (s Schema) MarshalJSON() ([]byte, error) {
+	defs := make(map[string]*fieldDef, len(s))
+	for name, v := range s {
+		def, err := newFieldDef(v)
+		if err != nil {
+			return nil, fmt.Errorf("valet: field %q: %w", name, err)
+		}
+		defs[name] = def
+	}
+	return json.Marshal(defs)
+}
+
+// UnmarshalJSON decodes the declarative format written by MarshalJSON
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	var defs map[string]*fieldDef
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return err
+	}
+	schema, err := buildSchemaDefs(defs, "")
+	if err != nil {
+		return err
+	}
+	*s = schema
+	return nil
+}
+
+// buildSchemaDefs builds the validators of an object shape
+func buildSchemaDefs(defs map[string]*fieldDef, prefix string) (Schema, error) {
+	schema := make(Schema, len(defs))
+	for name, def := range defs {
+		if def == nil {
+			return nil, &fieldDefError{path: prefix + name, err: errors.New("empty definition")}
+		}
+		v, err := def.build(prefix + name)
+		if err != nil {
+			return nil, err
+		}
+		schema[name] = v
+	}
+	return schema, nil
+}
+
+// newFieldDef encodes a validator
+func newFieldDef(v Validator) (*fieldDef, error) {
+	if name, ok := registeredName(v); ok {
+		return &fieldDef{Ref: name}, nil
+	}
+	if o, ok := v.(*OptionalValidator); ok {
+		def, err := newFieldDef(o.inner)
+		if err != nil {
+			return nil, err
+		}
+		def.Optional = true
+		return def, nil
+	}
+	describer, ok := v.(Describer)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a built-in validator; register it with RegisterValidator", v)
+	}
+	d := describer.Describe()
+
+	def := &fieldDef{Type: d.Type, Required: d.Required, Nullable: d.Nullable, Default: d.Default}
+	for _, r := range d.Rules {
+		switch r.Name {
+		case "requiredIf", "requiredUnless", "custom", "transform":
+			return nil, fmt.Errorf("%s rule is Go code; register the validator with RegisterValidator", r.Name)
+		case "union":
+			continue
+		}
+		if def.Rules == nil {
+			def.Rules = make(map[string]any)
+		}
+		if r.Param == nil {
+			def.Rules[r.Name] = true
+		} else {
+			def.Rules[r.Name] = r.Param
+		}
+	}
+	addValidatorOptions(def, v)
+
+	for rule, msg := range d.Messages {
+		s, ok := msg.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s message is a MessageFunc; register the validator with RegisterValidator", rule)
+		}
+		if def.Messages == nil {
+			def.Messages = make(map[string]string)
+		}
+		def.Messages[rule] = s
+	}
+
+	for _, r := range d.DB {
+		if r.Func {
+			return nil, fmt.Errorf("ExistsFunc is Go code; register the validator with RegisterValidator")
+		}
+		db := dbRuleDef{Rule: r.Rule, Table: r.Table, Column: r.Column, Load: r.Load, Unscoped: r.Unscoped, Ignore: r.Ignore}
+		if r.Query != nil {
+			name, ok := registeredQueryName(*r.Query)
+			if !ok {
+				return nil, fmt.Errorf("ExistsQuery SQL is not stored in schemas; register it with RegisterQuery")
+			}
+			db.Query = name
+		}
+		db.Where = newWhereDefs(r.Where)
+		def.DB = append(def.DB, db)
+	}
+
+	var err error
+	if len(d.Fields) > 0 {
+		obj := v.(*ObjectValidator)
+		def.Fields = make(map[string]*fieldDef, len(obj.schema))
+		for name, field := range obj.schema {
+			if def.Fields[name], err = newFieldDef(field); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	if arr, ok := v.(*ArrayValidator); ok && arr.element != nil {
+		if def.Element, err = newFieldDef(arr.element); err != nil {
+			return nil, fmt.Errorf("element: %w", err)
+		}
+	}
+	if u, ok := v.(*UnionValidator); ok {
+		for i, variant := range u.validators {
+			vdef, err := newFieldDef(variant)
+			if err != nil {
+				return nil, fmt.Errorf("variant %d: %w", i, err)
+			}
+			def.Variants = append(def.Variants, vdef)
+		}
+	}
+	return def, nil
+}
+
+// addValidatorOptions encodes settings that are not rules in Describe
+func addValidatorOptions(def *fieldDef, v Validator) {
+	set := func(name string, param any) {
+		if def.Rules == nil {
+			def.Rules = make(map[string]any)
+		}
+		def.Rules[name] = param
+	}
+	switch t := v.(type) {
+	case *StringValidator:
+		if t.urlOptions != nil {
+			set("url", map[string]bool{"http": t.urlOptions.Http, "https": t.urlOptions.Https})
+		}
+	case *ArrayValidator:
+		if t.concurrent > 0 {
+			set("concurrent", t.concurrent)
+		}
+		if t.dbSummary {
+			set("summarizeDBErrors", true)
+		}
+	case *TimeValidator:
+		if t.betweenStart != nil && t.betweenEnd != nil {
+			set("between", []string{t.betweenStart.Format(t.format), t.betweenEnd.Format(t.format)})
+		}
+		if t.timezone != nil {
+			set("timezone", t.timezone.String())
+		}
+	}
+}
+
+func newWhereDefs(clauses []WhereClause) []whereDef {
+	if len(clauses) == 0 {
+		return nil
+	}
+	defs := make([]whereDef, len(clauses))
+	for i, w := range clauses {
+		defs[i] = whereDef{Column: w.Column, Operator: w.Operator, Value: w.Value, Field: w.Field, Or: newWhereDefs(w.Or)}
+	}
+	return defs
+}
+
+func (w whereDef) clause() WhereClause {
+	c := WhereClause{Column: w.Column, Operator: w.Operator, Value: w.Value, Field: w.Field}
+	for _, sub := range w.Or {
+		c.Or = append(c.Or, sub.clause())
+	}
+	return c
+}
+
+// build decodes a validator; path is used in errors
+func (def *fieldDef) build(path string) (Validator, error) {
+	if err := unknownKeyError(def.unknown); err != nil {
+		return nil, &fieldDefError{path: path, err: err}
+	}
+	v, err := def.buildValidator(path)
+	if err != nil {
+		return nil, err
+	}
+	if def.Optional {
+		return Optional(v), nil
+	}
+	return v, nil
+}
+
+func (def *fieldDef) buildValidator(path string) (Validator, error) {
+	if def.Ref != "" {
+		v, ok := registeredValidator(def.Ref)
+		if !ok {
+			return nil, &fieldDefError{path: path, err: fmt.Errorf("no validator registered as %q", def.Ref)}
+		}
+		return v, nil
+	}
+
+	var (
+		v   Validator
+		err error
+	)
+	switch def.Type {
+	case "string":
+		v, err = def.buildString()
+	case "number":
+		v, err = buildNumberDef(def, Float())
+	case "integer":
+		v, err = buildNumberDef(def, Int())
+	case "boolean":
+		v, err = def.buildBool()
+	case "array":
+		v, err = def.buildArray(path)
+	case "object":
+		v, err = def.buildObject(path)
+	case "file":
+		v, err = def.buildFile()
+	case "time":
+		v, err = def.buildTime()
+	case "enum":
+		v, err = def.buildEnum()
+	case "literal":
+		v, err = def.buildLiteral()
+	case "union":
+		v, err = def.buildUnion(path)
+	case "any":
+		a := Any()
+		if def.Required {
+			a.Required()
+		}
+		if def.Nullable {
+			a.Nullable()
+		}
+		for rule, msg := range def.Messages {
+			a.Message(rule, msg)
+		}
+		v, err = a, def.checkRules()
+	default:
+		err = fmt.Errorf("unknown type %q", def.Type)
+	}
+	var nested *fieldDefError
+	if errors.As(err, &nested) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, &fieldDefError{path: path, err: err}
+	}
+	return v, nil
+}
+
+// fieldDefError is a load error of the field at path. Errors of nested
+// fields are returned as-is so they name the innermost path.
+type fieldDefError struct {
+	path string
+	err  error
+}
+
+func (e *fieldDefError) Error() string {
+	return fmt.Sprintf("valet: field %q: %v", e.path, e.err)
+}
+
+func (e *fieldDefError) Unwrap() error {
+	return e.err
+}
+
+// ruleParams converts rule parameters decoded from JSON
+type ruleParams struct {
+	rules map[string]any
+	used  map[string]bool
+	err   error
+}
+
+func (def *fieldDef) params() *ruleParams {
+	return &ruleParams{rules: def.Rules, used: make(map[string]bool)}
+}
+
+// has reports whether a rule is set and marks it handled
+func (p *ruleParams) has(name string) bool {
+	v, ok := p.rules[name]
+	p.used[name] = true
+	return ok && v != false
+}
+
+func (p *ruleParams) fail(name, want string) {
+	if p.err == nil {
+		p.err = fmt.Errorf("rule %q expects %s, got %v", name, want, p.rules[name])
+	}
+}
+
+func (p *ruleParams) int(name string) int {
+	f, ok := p.rules[name].(float64)
+	if !ok || f != float64(int(f)) {
+		p.fail(name, "an integer")
+	}
+	return int(f)
+}
+
+func (p *ruleParams) float(name string) float64 {
+	f, ok := p.rules[name].(float64)
+	if !ok {
+		p.fail(name, "a number")
+	}
+	return f
+}
+
+func (p *ruleParams) string(name string) string {
+	s, ok := p.rules[name].(string)
+	if !ok {
+		p.fail(name, "a string")
+	}
+	return s
+}
+
+// pattern returns a regex parameter, which must compile
+func (p *ruleParams) pattern(name string) string {
+	s := p.string(name)
+	if _, err := regexp.Compile(s); err != nil && p.err == nil {
+		p.err = fmt.Errorf("rule %q: invalid pattern: %w", name, err)
+	}
+	return s
+}
+
+func (p *ruleParams) list(name string) []any {
+	list, ok := p.rules[name].([]any)
+	if !ok {
+		p.fail(name, "a list")
+	}
+	return list
+}
+
+func (p *ruleParams) strings(name string) []string {
+	list := p.list(name)
+	out := make([]string, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			p.fail(name, "a list of strings")
+		}
+		out[i] = s
+	}
+	return out
+}
+
+func (p *ruleParams) floats(name string) []float64 {
+	list := p.list(name)
+	out := make([]float64, len(list))
+	for i, item := range list {
+		f, ok := item.(float64)
+		if !ok {
+			p.fail(name, "a list of numbers")
+		}
+		out[i] = f
+	}
+	return out
+}
+
+// done returns the first conversion error or an unknown rule
+func (p *ruleParams) done() error {
+	if p.err != nil {
+		return p.err
+	}
+	names := make([]string, 0, len(p.rules))
+	for name := range p.rules {
+		if !p.used[name] {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		sort.Strings(names)
+		return fmt.Errorf("unknown rules: %s", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// checkRules rejects rules on types that have none
+func (def *fieldDef) checkRules() error {
+	return def.params().done()
+}
+
+// stringFlagRules maps flag rules to their StringValidator methods
+var stringFlagRules = map[string]func(*StringValidator){
+	"email":        func(v *StringValidator) { v.Email() },
+	"alpha":        func(v *StringValidator) { v.Alpha() },
+	"alphaNumeric": func(v *StringValidator) { v.AlphaNumeric() },
+	"alphaDash":    func(v *StringValidator) { v.AlphaDash() },
+	"ascii":        func(v *StringValidator) { v.ASCII() },
+	"uuid":         func(v *StringValidator) { v.UUID() },
+	"ip":           func(v *StringValidator) { v.IP() },
+	"ipv4":         func(v *StringValidator) { v.IPv4() },
+	"ipv6":         func(v *StringValidator) { v.IPv6() },
+	"json":         func(v *StringValidator) { v.JSON() },
+	"hexColor":     func(v *StringValidator) { v.HexColor() },
+	"base64":       func(v *StringValidator) { v.Base64() },
+	"mac":          func(v *StringValidator) { v.MAC() },
+	"ulid":         func(v *StringValidator) { v.ULID() },
+	"trim":         func(v *StringValidator) { v.Trim() },
+	"lowercase":    func(v *StringValidator) { v.Lowercase() },
+	"uppercase":    func(v *StringValidator) { v.Uppercase() },
+}
+
+// stringParamRules maps string-parameter rules to their StringValidator methods
+var stringParamRules = map[string]func(*StringValidator, string){
+	"startsWith":    func(v *StringValidator, s string) { v.StartsWith(s) },
+	"endsWith":      func(v *StringValidator, s string) { v.EndsWith(s) },
+	"contains":      func(v *StringValidator, s string) { v.Contains(s) },
+	"sameAs":        func(v *StringValidator, s string) { v.SameAs(s) },
+	"differentFrom": func(v *StringValidator, s string) { v.DifferentFrom(s) },
+}
+
+// stringListRules maps list rules to their StringValidator methods
+var stringListRules = map[string]func(*StringValidator, []string){
+	"in":              func(v *StringValidator, s []string) { v.In(s...) },
+	"notIn":           func(v *StringValidator, s []string) { v.NotIn(s...) },
+	"doesntStartWith": func(v *StringValidator, s []string) { v.DoesntStartWith(s...) },
+	"doesntEndWith":   func(v *StringValidator, s []string) { v.DoesntEndWith(s...) },
+	"includes":        func(v *StringValidator, s []string) { v.Includes(s...) },
+}
+
+func (def *fieldDef) buildString() (Validator, error) {
+	v := String()
+	p := def.params()
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if def.Default != nil {
+		s, ok := def.Default.(string)
+		if !ok {
+			return nil, fmt.Errorf("default must be a string")
+		}
+		v.Default(s)
+	}
+	for name, apply := range stringFlagRules {
+		if p.has(name) {
+			apply(v)
+		}
+	}
+	for name, apply := range stringParamRules {
+		if p.has(name) {
+			apply(v, p.string(name))
+		}
+	}
+	for name, apply := range stringListRules {
+		if p.has(name) {
+			apply(v, p.strings(name))
+		}
+	}
+	if p.has("regex") {
+		v.Regex(p.pattern("regex"))
+	}
+	if p.has("notRegex") {
+		v.NotRegex(p.pattern("notRegex"))
+	}
+	if p.has("min") {
+		v.Min(p.int("min"))
+	}
+	if p.has("max") {
+		v.Max(p.int("max"))
+	}
+	if p.has("length") {
+		v.Length(p.int("length"))
+	}
+	if p.has("digits") {
+		v.Digits(p.int("digits"))
+	}
+	if p.has("url") {
+		if opts, ok := p.rules["url"].(map[string]any); ok {
+			http, _ := opts["http"].(bool)
+			https, _ := opts["https"].(bool)
+			v.URLWithOptions(UrlOptions{Http: http, Https: https})
+		} else {
+			v.URL()
+		}
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	for _, db := range def.DB {
+		switch db.Rule {
+		case "exists":
+			exists, err := db.existsRule()
+			if err != nil {
+				return nil, err
+			}
+			v.exists = exists
+		case "unique":
+			v.unique = db.uniqueRule()
+		default:
+			return nil, fmt.Errorf("unknown DB rule %q", db.Rule)
+		}
+	}
+	return v, validateDBDefs(def.DB)
+}
+
+func buildNumberDef[T Number](def *fieldDef, v *NumberValidator[T]) (Validator, error) {
+	p := def.params()
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if def.Default != nil {
+		f, ok := def.Default.(float64)
+		if !ok {
+			return nil, fmt.Errorf("default must be a number")
+		}
+		v.Default(T(f))
+	}
+	if p.has("coerce") {
+		v.Coerce()
+	}
+	if p.has("min") {
+		v.Min(T(p.float("min")))
+	}
+	if p.has("max") {
+		v.Max(T(p.float("max")))
+	}
+	if p.has("positive") {
+		v.Positive()
+	}
+	if p.has("negative") {
+		v.Negative()
+	}
+	if p.has("integer") {
+		v.Integer()
+	}
+	if p.has("multipleOf") {
+		v.MultipleOf(T(p.float("multipleOf")))
+	}
+	if p.has("in") {
+		v.In(convertFloats[T](p.floats("in"))...)
+	}
+	if p.has("notIn") {
+		v.NotIn(convertFloats[T](p.floats("notIn"))...)
+	}
+	if p.has("minDigits") {
+		v.MinDigits(p.int("minDigits"))
+	}
+	if p.has("maxDigits") {
+		v.MaxDigits(p.int("maxDigits"))
+	}
+	if p.has("regex") {
+		v.Regex(p.pattern("regex"))
+	}
+	if p.has("notRegex") {
+		v.NotRegex(p.pattern("notRegex"))
+	}
+	if p.has("lessThan") {
+		v.LessThan(p.string("lessThan"))
+	}
+	if p.has("greaterThan") {
+		v.GreaterThan(p.string("greaterThan"))
+	}
+	if p.has("lessThanOrEqual") {
+		v.LessThanOrEqual(p.string("lessThanOrEqual"))
+	}
+	if p.has("greaterThanOrEqual") {
+		v.GreaterThanOrEqual(p.string("greaterThanOrEqual"))
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	for _, db := range def.DB {
+		switch db.Rule {
+		case "exists":
+			exists, err := db.existsRule()
+			if err != nil {
+				return nil, err
+			}
+			v.exists = exists
+		case "unique":
+			v.unique = db.uniqueRule()
+		default:
+			return nil, fmt.Errorf("unknown DB rule %q", db.Rule)
+		}
+	}
+	return v, validateDBDefs(def.DB)
+}
+
+func convertFloats[T Number](values []float64) []T {
+	out := make([]T, len(values))
+	for i, f := range values {
+		out[i] = T(f)
+	}
+	return out
+}
+
+func (def *fieldDef) buildBool() (Validator, error) {
+	v := Bool()
+	p := def.params()
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if def.Default != nil {
+		b, ok := def.Default.(bool)
+		if !ok {
+			return nil, fmt.Errorf("default must be a boolean")
+		}
+		v.Default(b)
+	}
+	if p.has("coerce") {
+		v.Coerce()
+	}
+	if p.has("true") {
+		v.True()
+	}
+	if p.has("false") {
+		v.False()
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v, nil
+}
+
+func (def *fieldDef) buildArray(path string) (Validator, error) {
+	v := Array()
+	p := def.params()
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if def.Element != nil {
+		element, err := def.Element.build(path + ".*")
+		if err != nil {
+			return nil, err
+		}
+		v.Of(element)
+	}
+	if p.has("min") {
+		v.Min(p.int("min"))
+	}
+	if p.has("max") {
+		v.Max(p.int("max"))
+	}
+	if p.has("length") {
+		v.Length(p.int("length"))
+	}
+	if p.has("unique") {
+		v.Unique()
+	}
+	if p.has("contains") {
+		v.Contains(p.list("contains")...)
+	}
+	if p.has("doesntContain") {
+		v.DoesntContain(p.list("doesntContain")...)
+	}
+	if p.has("concurrent") {
+		v.Concurrent(p.int("concurrent"))
+	}
+	if p.has("summarizeDBErrors") {
+		v.SummarizeDBErrors()
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	for _, db := range def.DB {
+		switch db.Rule {
+		case "exists":
+			exists, err := db.existsRule()
+			if err != nil {
+				return nil, err
+			}
+			v.exists = exists
+		case "unique":
+			v.uniqueInDB = db.uniqueRule()
+		default:
+			return nil, fmt.Errorf("unknown DB rule %q", db.Rule)
+		}
+	}
+	return v, validateDBDefs(def.DB)
+}
+
+func (def *fieldDef) buildObject(path string) (Validator, error) {
+	shape, err := buildSchemaDefs(def.Fields, path+".")
+	if err != nil {
+		return nil, err
+	}
+	v := Object().Shape(shape)
+	p := def.params()
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if p.has("strict") {
+		v.Strict()
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v, nil
+}
+
+func (def *fieldDef) buildFile() (Validator, error) {
+	v := File()
+	p := def.params()
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if p.has("min") {
+		v.Min(int64(p.float("min")))
+	}
+	if p.has("max") {
+		v.Max(int64(p.float("max")))
+	}
+	if p.has("mimes") {
+		v.Mimes(p.strings("mimes")...)
+	}
+	if p.has("extensions") {
+		v.Extensions(p.strings("extensions")...)
+	}
+	if p.has("image") {
+		v.Image()
+	}
+	if p.has("dimensions") {
+		var d ImageDimensions
+		b, _ := json.Marshal(p.rules["dimensions"])
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&d); err != nil {
+			p.fail("dimensions", "an object")
+		}
+		v.Dimensions(&d)
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v, nil
+}
+
+func (def *fieldDef) buildTime() (Validator, error) {
+	v := Time()
+	p := def.params()
+	if p.has("format") {
+		v.Format(p.string("format"))
+	}
+	if p.has("timezone") {
+		loc, err := time.LoadLocation(p.string("timezone"))
+		if err != nil {
+			return nil, err
+		}
+		v.Timezone(loc)
+	}
+	parse := func(name string, s string) time.Time {
+		t, err := time.Parse(v.format, s)
+		if err != nil && p.err == nil {
+			p.err = fmt.Errorf("rule %q: %w", name, err)
+		}
+		return t
+	}
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if def.Default != nil {
+		s, ok := def.Default.(string)
+		if !ok {
+			return nil, fmt.Errorf("default must be a time string")
+		}
+		v.Default(parse("default", s))
+	}
+	if p.has("after") {
+		v.After(parse("after", p.string("after")))
+	}
+	if p.has("before") {
+		v.Before(parse("before", p.string("before")))
+	}
+	if p.has("afterField") {
+		v.AfterField(p.string("afterField"))
+	}
+	if p.has("beforeField") {
+		v.BeforeField(p.string("beforeField"))
+	}
+	if p.has("between") {
+		bounds := p.strings("between")
+		if len(bounds) != 2 {
+			p.fail("between", "a list of two times")
+		} else {
+			v.Between(parse("between", bounds[0]), parse("between", bounds[1]))
+		}
+	}
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v, nil
+}
+
+func (def *fieldDef) buildEnum() (Validator, error) {
+	p := def.params()
+	if !p.has("enum") {
+		return nil, fmt.Errorf("enum rule is required")
+	}
+	values := p.list("enum")
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	switch {
+	case allOf[string](values):
+		return enumDef(def, typedValues[string](values))
+	case allOf[float64](values):
+		return enumDef(def, typedValues[float64](values))
+	}
+	return enumDef(def, values)
+}
+
+func enumDef[T comparable](def *fieldDef, values []T) (Validator, error) {
+	v := Enum(values...)
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	if def.Default != nil {
+		d, ok := def.Default.(T)
+		if !ok {
+			return nil, fmt.Errorf("default must match the enum values")
+		}
+		v.Default(d)
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v, nil
+}
+
+func (def *fieldDef) buildLiteral() (Validator, error) {
+	p := def.params()
+	if !p.has("literal") {
+		return nil, fmt.Errorf("literal rule is required")
+	}
+	value := p.rules["literal"]
+	if err := p.done(); err != nil {
+		return nil, err
+	}
+	switch t := value.(type) {
+	case string:
+		return literalDef(def, t), nil
+	case float64:
+		return literalDef(def, t), nil
+	case bool:
+		return literalDef(def, t), nil
+	}
+	return nil, fmt.Errorf("literal must be a string, number or boolean")
+}
+
+func literalDef[T comparable](def *fieldDef, value T) Validator {
+	v := Literal(value)
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v
+}
+
+func (def *fieldDef) buildUnion(path string) (Validator, error) {
+	variants := make([]Validator, len(def.Variants))
+	for i, vdef := range def.Variants {
+		if vdef == nil {
+			return nil, fmt.Errorf("variant %d: empty definition", i)
+		}
+		variant, err := vdef.build(fmt.Sprintf("%s|%d", path, i))
+		if err != nil {
+			return nil, err
+		}
+		variants[i] = variant
+	}
+	if err := def.checkRules(); err != nil {
+		return nil, err
+	}
+	v := Union(variants...)
+	if def.Required {
+		v.Required()
+	}
+	if def.Nullable {
+		v.Nullable()
+	}
+	for rule, msg := range def.Messages {
+		v.Message(rule, msg)
+	}
+	return v, nil
+}
+
+func allOf[T any](values []any) bool {
+	for _, v := range values {
+		if _, ok := v.(T); !ok {
+			return false
+		}
+	}
+	return len(values) > 0
+}
+
+func typedValues[T any](values []any) []T {
+	out := make([]T, len(values))
+	for i, v := range values {
+		out[i] = v.(T)
+	}
+	return out
+}
+
+func (db dbRuleDef) wheres() []WhereClause {
+	if len(db.Where) == 0 {
+		return nil
+	}
+	clauses := make([]WhereClause, len(db.Where))
+	for i, w := range db.Where {
+		clauses[i] = w.clause()
+	}
+	return clauses
+}
+
+// existsRule builds an Exists rule; a query names a template registered with
+// RegisterQuery, which is checked now rather than when it first runs
+func (db dbRuleDef) existsRule() (*ExistsRule, error) {
+	if db.Query == "" {
+		return &ExistsRule{Table: db.Table, Column: db.Column, Where: db.wheres(), Load: db.Load, Unscoped: db.Unscoped}, nil
+	}
+	q, ok := registeredQuery(db.Query)
+	if !ok {
+		return nil, fmt.Errorf("no query registered as %q", db.Query)
+	}
+	if err := q.validate(); err != nil {
+		return nil, fmt.Errorf("query %q: %w", db.Query, err)
+	}
+	return &ExistsRule{Query: &q, Unscoped: db.Unscoped}, nil
+}
+
+func (db dbRuleDef) uniqueRule() *UniqueRule {
+	return &UniqueRule{Table: db.Table, Column: db.Column, Where: db.wheres(), Ignore: db.Ignore, Unscoped: db.Unscoped}
+}
+
+// checkWhereKeys rejects unknown keys in where clauses and their OR groups
+func checkWhereKeys(wheres []whereDef) error {
+	for _, w := range wheres {
+		if err := unknownKeyError(w.unknown); err != nil {
+			return err
+		}
+		if err := checkWhereKeys(w.Or); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDBDefs rejects unsafe identifiers and operators in loaded DB rules
+func validateDBDefs(defs []dbRuleDef) error {
+	for _, db := range defs {
+		if err := unknownKeyError(db.unknown); err != nil {
+			return fmt.Errorf("%s rule: %w", db.Rule, err)
+		}
+		if err := checkWhereKeys(db.Where); err != nil {
+			return fmt.Errorf("%s rule: where: %w", db.Rule, err)
+		}
+		if db.Query != "" {
+			if db.Rule != "exists" {
+				return fmt.Errorf("query is only supported by exists rules")
+			}
+			continue
+		}
+		for _, name := range []string{db.Table, db.Column} {
+			if err := validateIdentifier(name); err != nil {
+				return err
+			}
+		}
+		for _, w := range db.wheres() {
+			if err := w.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package valet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustParseDate(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestSchemaJSON_RoundTrip(t *testing.T) {
+	original := Schema{
+		"name":  String().Required().Trim().Min(2).Max(50).Message("min", "Name is too short"),
+		"email": String().Required().Email().Unique("users", "email", nil, WhereEq("deleted", false)),
+		"role":  String().Default("user").In("admin", "user"),
+		"site":  Optional(String().URLWithOptions(UrlOptions{Https: true})),
+		"age":   Int().Nullable().Min(18).Max(120),
+		"price": Float().Positive().MultipleOf(0.01),
+		"terms": Bool().Required().True(),
+		"tags":  Array().Max(5).Unique().Concurrent(2).Of(String().Min(2)),
+		"items": Array().Exists("products", "id").SummarizeDBErrors(),
+		"address": Object().Required().Strict().Shape(Schema{
+			"city":       String().Required(),
+			"country_id": Int().ExistsAndLoad("countries", "id").Unscoped(),
+		}),
+		"avatar": File().Max(1<<20).Mimes("image/png", "image/jpeg"),
+		"start":  Time().Format("2006-01-02").After(mustParseDate("2024-01-01")).Message("after", "Too early"),
+		"status": Enum("draft", "published").Default("draft"),
+		"kind":   Literal("post"),
+		"ref":    Union(String().UUID(), Int()),
+		"meta":   Any(),
+	}
+
+	b, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	loaded, err := LoadSchema(strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatalf("LoadSchema error: %v", err)
+	}
+
+	again, err := json.Marshal(loaded)
+	if err != nil {
+		t.Fatalf("Marshal loaded error: %v", err)
+	}
+	if string(again) != string(b) {
+		t.Errorf("Round trip differs:\n%s\n%s", b, again)
+	}
+
+	data := DataObject{
+		"name":    "A",
+		"email":   "bad",
+		"terms":   false,
+		"tags":    []any{"go", "go"},
+		"address": map[string]any{"city": "Berlin", "zip": "1"},
+		"start":   "2023-12-31",
+		"status":  "archived",
+	}
+	want := Validate(data, original)
+	got := Validate(data, loaded)
+	if want == nil || got == nil || len(want.Errors) != len(got.Errors) {
+		t.Fatalf("Errors differ: original %v, loaded %v", want, got)
+	}
+	for path, msgs := range want.Errors {
+		if strings.Join(got.Errors[path], "|") != strings.Join(msgs, "|") {
+			t.Errorf("%s: original %v, loaded %v", path, msgs, got.Errors[path])
+		}
+	}
+}
+
+func TestLoadSchema_Document(t *testing.T) {
+	doc := `{
+		"email": {"type": "string", "required": true, "rules": {"email": true, "max": 100},
+			"messages": {"required": "Email is required"},
+			"db": [{"rule": "exists", "table": "users", "column": "email", "where": [{"column": "active", "operator": "=", "value": true}]}]},
+		"qty": {"type": "integer", "rules": {"min": 1}}
+	}`
+	schema, err := LoadSchema(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("LoadSchema error: %v", err)
+	}
+
+	verr := Validate(DataObject{"qty": float64(0)}, schema)
+	if verr == nil || verr.Errors["email"][0] != "Email is required" || len(verr.Errors["qty"]) != 1 {
+		t.Errorf("Unexpected errors: %v", verr)
+	}
+
+	d := DescribeSchema(schema)["email"]
+	if len(d.DB) != 1 || d.DB[0].Table != "users" || len(d.DB[0].Where) != 1 {
+		t.Errorf("Unexpected DB rules: %+v", d.DB)
+	}
+}
+
+func TestSchemaJSON_RegisteredValidators(t *testing.T) {
+	slug := String().Custom(func(s string, _ Lookup) error {
+		if strings.Contains(s, " ") {
+			return errors.New("slug must not contain spaces")
+		}
+		return nil
+	})
+	RegisterValidator("test.slug", slug)
+
+	b, err := json.Marshal(Schema{"slug": slug, "tags": Array().Of(slug)})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if !strings.Contains(string(b), `"ref":"test.slug"`) {
+		t.Errorf("Expected ref in %s", b)
+	}
+
+	schema, err := LoadSchema(strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatalf("LoadSchema error: %v", err)
+	}
+	verr := Validate(DataObject{"slug": "a b"}, schema)
+	if verr == nil || verr.Errors["slug"][0] != "slug must not contain spaces" {
+		t.Errorf("Unexpected errors: %v", verr)
+	}
+}
+
+func TestSchemaJSON_RegisteredQueries(t *testing.T) {
+	RegisterQuery("active_coupons", "SELECT code FROM coupons WHERE code IN ({values}) AND uses_left > ?", 0)
+
+	original := Schema{"coupon": String().ExistsQuery("SELECT code FROM coupons WHERE code IN ({values}) AND uses_left > ?", 0)}
+	b, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if want := `{"coupon":{"type":"string","db":[{"rule":"exists","query":"active_coupons"}]}}`; string(b) != want {
+		t.Errorf("Marshal = %s, want %s", b, want)
+	}
+
+	loaded, err := LoadSchema(strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatalf("LoadSchema error: %v", err)
+	}
+	db := &rawQuerier{dialect: SQLiteDialect{}, existing: map[any]bool{"SAVE10": true}}
+	verr := ValidateWithDB(context.Background(), DataObject{"coupon": "BOGUS"}, loaded, db)
+	if verr == nil || len(verr.Errors["coupon"]) != 1 {
+		t.Errorf("Expected coupon to fail, got %v", verr)
+	}
+	if len(db.queries) != 1 || !strings.Contains(db.queries[0], "uses_left > ?") {
+		t.Errorf("Unexpected queries: %v", db.queries)
+	}
+}
+
+func TestSchemaJSON_Errors(t *testing.T) {
+	unencodable := map[string]Schema{
+		"custom":     {"a": Float().Custom(func(float64, Lookup) error { return nil })},
+		"requiredIf": {"a": String().RequiredIf(func(DataObject) bool { return true })},
+		"messageFn":  {"a": String().Required(MessageFunc(func(MessageContext) string { return "x" }))},
+		"existsFunc": {"a": String().ExistsFunc(func(context.Context, DBChecker, []any) (map[any]bool, error) { return nil, nil })},
+		"validator":  {"a": customValidator{}},
+	}
+	for name, schema := range unencodable {
+		if _, err := json.Marshal(schema); err == nil {
+			t.Errorf("%s: expected marshal error", name)
+		}
+	}
+
+	RegisterQuery("test_coupons", "SELECT code FROM coupons WHERE code IN ({values})")
+	RegisterQuery("test_no_token", "SELECT 1 FROM coupons")
+	if _, err := json.Marshal(Schema{"a": String().ExistsQuery("SELECT 1 FROM plans WHERE id = {value}")}); err == nil {
+		t.Error("unregistered query: expected marshal error")
+	}
+
+	invalid := map[string]string{
+		"unknown type":  `{"a": {"type": "color"}}`,
+		"unknown rule":  `{"a": {"type": "string", "rules": {"shiny": true}}}`,
+		"bad param":     `{"a": {"type": "string", "rules": {"min": "three"}}}`,
+		"missing ref":   `{"a": {"ref": "nope"}}`,
+		"bad table":     `{"a": {"type": "string", "db": [{"rule": "exists", "table": "users; drop", "column": "id"}]}}`,
+		"bad operator":  `{"a": {"type": "string", "db": [{"rule": "exists", "table": "t", "column": "id", "where": [{"column": "x", "operator": "~"}]}]}}`,
+		"invalid JSON":  `{`,
+		"bad regex":     `{"a": {"type": "string", "rules": {"regex": "(?=x)"}}}`,
+		"bad notRegex":  `{"a": {"type": "integer", "rules": {"notRegex": "(?!1)"}}}`,
+		"nested errors": `{"a": {"type": "object", "fields": {"b": {"type": "string", "rules": {"nope": 1}}}}}`,
+		"missing query": `{"a": {"type": "string", "db": [{"rule": "exists", "query": "SELECT code FROM coupons WHERE code IN ({values})"}]}}`,
+		"unique query":  `{"a": {"type": "string", "db": [{"rule": "unique", "query": "test_coupons"}]}}`,
+		"bad query":     `{"a": {"type": "string", "db": [{"rule": "exists", "query": "test_no_token"}]}}`,
+		"unknown key":   `{"a": {"type": "string", "requried": true}}`,
+		"db key":        `{"a": {"type": "string", "db": [{"rule": "exists", "table": "t", "column": "id", "unscopd": true}]}}`,
+		"where key":     `{"a": {"type": "string", "db": [{"rule": "exists", "table": "t", "column": "id", "where": [{"or": [{"column": "x", "operator": "=", "vaule": 1}]}]}]}}`,
+		"dimension key": `{"a": {"type": "file", "rules": {"dimensions": {"MinWidht": 10}}}}`,
+	}
+	for name, doc := range invalid {
+		if _, err := LoadSchema(strings.NewReader(doc)); err == nil {
+			t.Errorf("%s: expected load error", name)
+		}
+	}
+
+	_, err := LoadSchema(strings.NewReader(`{"a": {"type": "object", "fields": {"b": {"type": "string", "nulable": true}}}}`))
+	if err == nil || err.Error() != `valet: field "a.b": unknown key "nulable"` {
+		t.Errorf("Expected unknown key error with the path, got %v", err)
+	}
+}
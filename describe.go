@@ -0,0 +1,176 @@
+package valet
+
+import "reflect"
+
+// Describer is implemented by all validators. Describe returns a read-only
+// description of the configured rules for exporters, docs and linting.
+type Describer interface {
+	Describe() Description
+}
+
+// Description is a read-only description of a validator
+type Description struct {
+	// Type is the value type: "string", "number", "integer", "boolean",
+	// "array", "object", "file", "time", "enum", "literal", "union", "any"
+	// or "custom" for validators that do not implement Describer
+	Type     string
+	Required bool
+	Nullable bool
+	Optional bool // Wrapped in Optional(): absent or empty values are valid
+	Default  any  // Value used when the field is nil (nil = none)
+	// Rules are the constraints and transforms in evaluation order, excluding
+	// Required, the type check, Default and DB rules
+	Rules []RuleDescription
+	// DB are the Exists and Unique rules, checked after local validation
+	DB []DBRuleDescription
+	// Messages are the custom messages by rule (string or MessageFunc)
+	Messages map[string]MessageArg
+	Fields   map[string]Description // Object shape
+	Element  *Description           // Array element
+	Variants []Description          // Union members
+}
+
+// RuleDescription describes a single rule
+type RuleDescription struct {
+	Name      string // Rule name as used in messages (e.g., "min", "requiredIf")
+	Param     any    // Rule parameter (e.g., 3 for Min(3)), nil when none
+	Transform bool   // Changes the value instead of checking it (e.g., "trim")
+}
+
+// DBRuleDescription describes an Exists or Unique rule
+type DBRuleDescription struct {
+	Rule     string // "exists" or "unique"
+	Table    string
+	Column   string
+	Where    []WhereClause
+	Query    *RawQuery // ExistsQuery template
+	Func     bool      // ExistsFunc callback
+	Load     bool      // ExistsAndLoad
+	Unscoped bool
+	Ignore   any // Unique: ignored value
+}
+
+// Rule returns the description of a rule by name
+func (d Description) Rule(name string) (RuleDescription, bool) {
+	for _, r := range d.Rules {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return RuleDescription{}, false
+}
+
+// DescribeSchema describes every field of a schema
+func DescribeSchema(schema Schema) map[string]Description {
+	out := make(map[string]Description, len(schema))
+	for field, validator := range schema {
+		out[field] = describeValidator(validator)
+	}
+	return out
+}
+
+// describeValidator describes validators that may not implement Describer
+func describeValidator(v Validator) Description {
+	if d, ok := v.(Describer); ok {
+		return d.Describe()
+	}
+	return Description{Type: "custom"}
+}
+
+// newDescription splits the rules used by Explain into a Description
+func newDescription(typ string, nullable bool, rules []ruleDesc, messages map[string]MessageArg) Description {
+	d := Description{Type: typ, Nullable: nullable}
+	for _, r := range rules {
+		switch {
+		case r.name == "required" && r.label == "":
+			d.Required = true
+		case r.name == "type" || r.db:
+		case r.whenNil:
+			d.Default = copyParam(r.param)
+		default:
+			name := r.name
+			if r.label != "" {
+				name = r.label
+			}
+			d.Rules = append(d.Rules, RuleDescription{Name: name, Param: copyParam(r.param), Transform: r.transform})
+		}
+	}
+	if len(messages) > 0 {
+		d.Messages = make(map[string]MessageArg, len(messages))
+		for rule, msg := range messages {
+			d.Messages[rule] = msg
+		}
+	}
+	return d
+}
+
+// stringMessages converts validators' plain string messages
+func stringMessages(messages map[string]string) map[string]MessageArg {
+	out := make(map[string]MessageArg, len(messages))
+	for rule, msg := range messages {
+		out[rule] = msg
+	}
+	return out
+}
+
+// describeDBRules describes Exists and Unique rules
+func describeDBRules(exists *ExistsRule, unique *UniqueRule) []DBRuleDescription {
+	var rules []DBRuleDescription
+	if exists != nil {
+		rules = append(rules, DBRuleDescription{
+			Rule:     "exists",
+			Table:    exists.Table,
+			Column:   exists.Column,
+			Where:    copyWheres(exists.Where),
+			Query:    copyRawQuery(exists.Query),
+			Func:     exists.Func != nil,
+			Load:     exists.Load,
+			Unscoped: exists.Unscoped,
+		})
+	}
+	if unique != nil {
+		rules = append(rules, DBRuleDescription{
+			Rule:     "unique",
+			Table:    unique.Table,
+			Column:   unique.Column,
+			Where:    copyWheres(unique.queryWheres()),
+			Unscoped: unique.Unscoped,
+			Ignore:   copyParam(unique.Ignore),
+		})
+	}
+	return rules
+}
+
+// copyParam copies slice parameters (e.g., In values, MIME types) so a
+// Description cannot modify the validator
+func copyParam(param any) any {
+	v := reflect.ValueOf(param)
+	if v.Kind() != reflect.Slice || v.IsNil() {
+		return param
+	}
+	c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(c, v)
+	return c.Interface()
+}
+
+// copyWheres copies where clauses, including OR groups and slice values
+func copyWheres(wheres []WhereClause) []WhereClause {
+	if wheres == nil {
+		return nil
+	}
+	out := make([]WhereClause, len(wheres))
+	for i, w := range wheres {
+		w.Value = copyParam(w.Value)
+		w.Or = copyWheres(w.Or)
+		out[i] = w
+	}
+	return out
+}
+
+// copyRawQuery copies an ExistsQuery rule's query and arguments
+func copyRawQuery(q *RawQuery) *RawQuery {
+	if q == nil {
+		return nil
+	}
+	return &RawQuery{SQL: q.SQL, Args: copyParam(q.Args).([]any)}
+}
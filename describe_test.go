@@ -0,0 +1,152 @@
+package valet
+
+import "testing"
+
+func TestDescribe_String(t *testing.T) {
+	d := String().Required().Trim().Min(3).Max(50).Email().
+		Unique("users", "email", 5).
+		Message("min", "too short").
+		Describe()
+
+	if d.Type != "string" || !d.Required || d.Nullable || d.Optional {
+		t.Errorf("Unexpected description: %+v", d)
+	}
+	names := make([]string, len(d.Rules))
+	for i, r := range d.Rules {
+		names[i] = r.Name
+	}
+	if len(names) != 4 || names[0] != "trim" || names[1] != "min" || names[3] != "email" {
+		t.Errorf("Rules = %v", names)
+	}
+	if min, ok := d.Rule("min"); !ok || min.Param != 3 || min.Transform {
+		t.Errorf("min = %+v", min)
+	}
+	if trim, _ := d.Rule("trim"); !trim.Transform {
+		t.Error("Expected trim to be a transform")
+	}
+	if len(d.DB) != 1 || d.DB[0].Rule != "unique" || d.DB[0].Table != "users" || d.DB[0].Ignore != 5 {
+		t.Errorf("DB = %+v", d.DB)
+	}
+	if d.Messages["min"] != "too short" {
+		t.Errorf("Messages = %v", d.Messages)
+	}
+}
+
+func TestDescribe_Number(t *testing.T) {
+	d := Int().Nullable().Default(1).MultipleOf(5).Exists("items", "id").Describe()
+	if d.Type != "integer" || !d.Nullable || d.Default != int64(1) {
+		t.Errorf("Unexpected description: %+v", d)
+	}
+	if r, ok := d.Rule("multipleOf"); !ok || r.Param != int64(5) {
+		t.Errorf("multipleOf = %+v", r)
+	}
+	if len(d.DB) != 1 || d.DB[0].Rule != "exists" || d.DB[0].Column != "id" {
+		t.Errorf("DB = %+v", d.DB)
+	}
+	if Float().Describe().Type != "number" {
+		t.Error("Expected Float to be a number")
+	}
+}
+
+func TestDescribe_Nested(t *testing.T) {
+	isDE := func(d DataObject) bool { return d["country"] == "DE" }
+	schema := Schema{
+		"address": Object().Required().Shape(Schema{
+			"city": String().Required(),
+			"zip":  String().RequiredIf(isDE),
+		}),
+		"tags":   Array().Min(1).Of(String().Max(10)),
+		"nick":   Optional(String().Required().Min(2)),
+		"id":     Union(String(), Float()),
+		"custom": customValidator{},
+	}
+
+	desc := DescribeSchema(schema)
+
+	address := desc["address"]
+	if address.Type != "object" || len(address.Fields) != 2 || !address.Fields["city"].Required {
+		t.Errorf("address = %+v", address)
+	}
+	zip := address.Fields["zip"]
+	if zip.Required {
+		t.Error("RequiredIf should not mark the field as required")
+	}
+	if _, ok := zip.Rule("requiredIf"); !ok {
+		t.Errorf("zip rules = %+v", zip.Rules)
+	}
+
+	tags := desc["tags"]
+	if tags.Element == nil || tags.Element.Type != "string" {
+		t.Fatalf("tags = %+v", tags)
+	}
+	if r, _ := tags.Element.Rule("max"); r.Param != 10 {
+		t.Errorf("element max = %+v", r)
+	}
+
+	nick := desc["nick"]
+	if nick.Type != "string" || !nick.Optional || nick.Required {
+		t.Errorf("nick = %+v", nick)
+	}
+
+	id := desc["id"]
+	if id.Type != "union" || len(id.Variants) != 2 || id.Variants[1].Type != "number" {
+		t.Errorf("id = %+v", id)
+	}
+
+	if desc["custom"].Type != "custom" {
+		t.Errorf("custom = %+v", desc["custom"])
+	}
+}
+
+func TestDescribe_AllValidators(t *testing.T) {
+	validators := map[string]Validator{
+		"boolean": Bool(),
+		"file":    File(),
+		"time":    Time(),
+		"enum":    Enum("a", "b"),
+		"literal": Literal("x"),
+		"any":     Any(),
+	}
+	for want, v := range validators {
+		d, ok := v.(Describer)
+		if !ok {
+			t.Errorf("%s does not implement Describer", want)
+			continue
+		}
+		if got := d.Describe().Type; got != want {
+			t.Errorf("Type = %q, want %q", got, want)
+		}
+	}
+}
+
+// customValidator is a Validator that does not implement Describer
+type customValidator struct{}
+
+func (customValidator) Validate(*ValidationContext, any) map[string][]string { return nil }
+
+func TestDescribe_DoesNotShareState(t *testing.T) {
+	s := String().In("a", "b").Exists("users", "email", WhereIn("role", "admin", "owner"))
+	d := s.Describe()
+	r, _ := d.Rule("in")
+	r.Param.([]string)[0] = "x"
+	d.DB[0].Where[0].Value.([]any)[0] = "x"
+	if err := Validate(DataObject{"v": "a"}, Schema{"v": s}); err != nil {
+		t.Errorf("Description changed the validator: %v", err.Errors)
+	}
+	if v := s.Describe().DB[0].Where[0].Value.([]any)[0]; v != "admin" {
+		t.Errorf("Description changed the where clause: %v", v)
+	}
+
+	q := String().ExistsQuery("SELECT id FROM users WHERE id IN ({values}) AND org = ?", 7)
+	q.Describe().DB[0].Query.Args[0] = 8
+	if args := q.Describe().DB[0].Query.Args; args[0] != 7 {
+		t.Errorf("Description changed the query: %v", args)
+	}
+
+	f := File().Mimes("pdf")
+	fr, _ := f.Describe().Rule("mimes")
+	fr.Param.([]string)[0] = "exe"
+	if fr2, _ := f.Describe().Rule("mimes"); fr2.Param.([]string)[0] != "pdf" {
+		t.Errorf("Description changed the MIME types: %v", fr2.Param)
+	}
+}
@@ -0,0 +1,199 @@
+package valet
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dialect describes how an adapter renders SQL for a specific database engine
+type Dialect interface {
+	// Name returns the dialect name (e.g., "postgres")
+	Name() string
+	// Placeholder returns the bind parameter for the n-th argument (1-based)
+	Placeholder(n int) string
+	// QuoteIdentifier quotes a table or column name, including dotted names
+	QuoteIdentifier(name string) string
+	// MaxParams returns the maximum bind parameters per statement (0 = unlimited)
+	MaxParams() int
+}
+
+// ArrayDialect is implemented by dialects that can bind a whole value list as
+// a single array parameter (e.g., Postgres "= ANY($1)")
+type ArrayDialect interface {
+	Dialect
+	// ArrayParam converts the value list into a driver-bindable array.
+	// Returning nil disables array binding and falls back to IN (...).
+	ArrayParam(values []any) any
+}
+
+// DefaultDialect keeps the historical behavior: "?" placeholders, unquoted
+// identifiers and no parameter limit
+var DefaultDialect Dialect = defaultDialect{}
+
+type defaultDialect struct{}
+
+func (defaultDialect) Name() string                       { return "default" }
+func (defaultDialect) Placeholder(int) string             { return "?" }
+func (defaultDialect) QuoteIdentifier(name string) string { return name }
+func (defaultDialect) MaxParams() int                     { return 0 }
+
+// PostgresDialect renders "$n" placeholders and double-quoted identifiers.
+// Set Array (e.g., to pq.Array) to bind value lists as "= ANY($1)".
+type PostgresDialect struct {
+	Array func(values []any) any
+}
+
+func (PostgresDialect) Name() string                       { return "postgres" }
+func (PostgresDialect) Placeholder(n int) string           { return "$" + strconv.Itoa(n) }
+func (PostgresDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, `"`, `"`) }
+func (PostgresDialect) MaxParams() int                     { return 65535 }
+func (d PostgresDialect) ArrayParam(values []any) any {
+	if d.Array == nil {
+		return nil
+	}
+	return d.Array(values)
+}
+
+// MySQLDialect renders "?" placeholders and backtick-quoted identifiers
+type MySQLDialect struct{}
+
+func (MySQLDialect) Name() string                       { return "mysql" }
+func (MySQLDialect) Placeholder(int) string             { return "?" }
+func (MySQLDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, "`", "`") }
+func (MySQLDialect) MaxParams() int                     { return 65535 }
+
+// SQLiteDialect renders "?" placeholders and double-quoted identifiers.
+// MaxParams uses the conservative pre-3.32 limit of 999.
+type SQLiteDialect struct{}
+
+func (SQLiteDialect) Name() string                       { return "sqlite" }
+func (SQLiteDialect) Placeholder(int) string             { return "?" }
+func (SQLiteDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, `"`, `"`) }
+func (SQLiteDialect) MaxParams() int                     { return 999 }
+
+// SQLServerDialect renders "@pn" placeholders and bracket-quoted identifiers
+type SQLServerDialect struct{}
+
+func (SQLServerDialect) Name() string                       { return "sqlserver" }
+func (SQLServerDialect) Placeholder(n int) string           { return "@p" + strconv.Itoa(n) }
+func (SQLServerDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, "[", "]") }
+func (SQLServerDialect) MaxParams() int                     { return 2100 }
+
+// quoteIdentifier quotes each dot-separated part, escaping embedded closing quotes
+func quoteIdentifier(name, open, closing string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = open + strings.ReplaceAll(part, closing, closing+closing) + closing
+	}
+	return strings.Join(parts, ".")
+}
+
+// existsQuery is a single rendered statement with its bind arguments
+type existsQuery struct {
+	SQL  string
+	Args []any
+}
+
+// buildExistsQueries validates and renders one or more existence queries for
+// the dialect, splitting the value list so no statement exceeds the dialect's
+// parameter limit
+func buildExistsQueries(d Dialect, table, column string, values []any, wheres []WhereClause) ([]existsQuery, error) {
+	return buildSelectQueries(d, table, column, values, wheres, false)
+}
+
+// buildLoadQueries is buildExistsQueries selecting whole rows (SELECT *)
+func buildLoadQueries(d Dialect, table, column string, values []any, wheres []WhereClause) ([]existsQuery, error) {
+	return buildSelectQueries(d, table, column, values, wheres, true)
+}
+
+func buildSelectQueries(d Dialect, table, column string, values []any, wheres []WhereClause, selectAll bool) ([]existsQuery, error) {
+	if d == nil {
+		d = DefaultDialect
+	}
+
+	if err := validateExistsQuery(table, column, wheres); err != nil {
+		return nil, err
+	}
+
+	if ad, ok := d.(ArrayDialect); ok {
+		if arr := ad.ArrayParam(values); arr != nil {
+			sql, args := renderExistsQuery(d, table, column, nil, arr, wheres, selectAll)
+			return []existsQuery{{SQL: sql, Args: args}}, nil
+		}
+	}
+
+	whereArgs := 0
+	for _, w := range wheres {
+		whereArgs += whereArgCount(w)
+	}
+
+	chunkSize := len(values)
+	if limit := d.MaxParams(); limit > 0 && limit-whereArgs < chunkSize {
+		chunkSize = limit - whereArgs
+		if chunkSize < 1 {
+			chunkSize = 1
+		}
+	}
+
+	if len(values) <= chunkSize {
+		sql, args := renderExistsQuery(d, table, column, values, nil, wheres, selectAll)
+		return []existsQuery{{SQL: sql, Args: args}}, nil
+	}
+
+	queries := make([]existsQuery, 0, (len(values)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(values); start += chunkSize {
+		end := start + chunkSize
+		if end > len(values) {
+			end = len(values)
+		}
+		sql, args := renderExistsQuery(d, table, column, values[start:end], nil, wheres, selectAll)
+		queries = append(queries, existsQuery{SQL: sql, Args: args})
+	}
+	return queries, nil
+}
+
+// renderExistsQuery renders a single statement from validated input. When arr
+// is non-nil the value list is bound as one array parameter instead of an IN list.
+// selectAll selects whole rows instead of the matched column.
+func renderExistsQuery(d Dialect, table, column string, values []any, arr any, wheres []WhereClause, selectAll bool) (string, []any) {
+	col := d.QuoteIdentifier(column)
+
+	var query strings.Builder
+	query.WriteString("SELECT ")
+	if selectAll {
+		query.WriteString("*")
+	} else {
+		query.WriteString(col)
+	}
+	query.WriteString(" FROM ")
+	query.WriteString(d.QuoteIdentifier(table))
+	query.WriteString(" WHERE ")
+	query.WriteString(col)
+
+	var args []any
+	if arr != nil {
+		args = make([]any, 0, 1+len(wheres))
+		args = append(args, arr)
+		query.WriteString(" = ANY(")
+		query.WriteString(d.Placeholder(1))
+		query.WriteString(")")
+	} else {
+		args = make([]any, 0, len(values)+len(wheres))
+		query.WriteString(" IN (")
+		for i, v := range values {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteString(d.Placeholder(len(args) + 1))
+			args = append(args, v)
+		}
+		query.WriteString(")")
+	}
+
+	for _, w := range wheres {
+		query.WriteString(" AND ")
+		args = renderWhere(d, &query, w, args)
+	}
+
+	return query.String(), args
+}
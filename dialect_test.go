@@ -0,0 +1,193 @@
+package valet
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDialectPlaceholders(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		want    string
+	}{
+		{DefaultDialect, "?"},
+		{PostgresDialect{}, "$3"},
+		{MySQLDialect{}, "?"},
+		{SQLiteDialect{}, "?"},
+		{SQLServerDialect{}, "@p3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.Name(), func(t *testing.T) {
+			if got := tt.dialect.Placeholder(3); got != tt.want {
+				t.Errorf("Placeholder(3) = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialectQuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		name    string
+		want    string
+	}{
+		{DefaultDialect, "users", "users"},
+		{PostgresDialect{}, "public.users", `"public"."users"`},
+		{PostgresDialect{}, `we"ird`, `"we""ird"`},
+		{MySQLDialect{}, "users", "`users`"},
+		{MySQLDialect{}, "we`ird", "`we``ird`"},
+		{SQLiteDialect{}, "users", `"users"`},
+		{SQLServerDialect{}, "dbo.users", "[dbo].[users]"},
+		{SQLServerDialect{}, "we]ird", "[we]]ird]"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.dialect.QuoteIdentifier(tt.name); got != tt.want {
+			t.Errorf("%s.QuoteIdentifier(%q) = %s, want %s", tt.dialect.Name(), tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBuildExistsQueries(t *testing.T) {
+	t.Run("postgres placeholders and quoting", func(t *testing.T) {
+		queries, _ := buildExistsQueries(PostgresDialect{}, "users", "id", []any{1, 2}, []WhereClause{WhereEq("status", "active")})
+		if len(queries) != 1 {
+			t.Fatalf("Expected 1 query, got %d", len(queries))
+		}
+
+		want := `SELECT "id" FROM "users" WHERE "id" IN ($1,$2) AND "status" = $3`
+		if queries[0].SQL != want {
+			t.Errorf("Query = %s, want %s", queries[0].SQL, want)
+		}
+		if len(queries[0].Args) != 3 || queries[0].Args[2] != "active" {
+			t.Errorf("Unexpected args: %v", queries[0].Args)
+		}
+	})
+
+	t.Run("postgres array binding", func(t *testing.T) {
+		type pgArray []any
+		d := PostgresDialect{Array: func(values []any) any { return pgArray(values) }}
+
+		queries, _ := buildExistsQueries(d, "users", "id", []any{1, 2, 3}, []WhereClause{WhereEq("status", "active")})
+		if len(queries) != 1 {
+			t.Fatalf("Expected 1 query, got %d", len(queries))
+		}
+
+		want := `SELECT "id" FROM "users" WHERE "id" = ANY($1) AND "status" = $2`
+		if queries[0].SQL != want {
+			t.Errorf("Query = %s, want %s", queries[0].SQL, want)
+		}
+		if arr, ok := queries[0].Args[0].(pgArray); !ok || len(arr) != 3 {
+			t.Errorf("Expected array arg with 3 values, got %v", queries[0].Args[0])
+		}
+	})
+
+	t.Run("mysql quoting", func(t *testing.T) {
+		queries, _ := buildExistsQueries(MySQLDialect{}, "users", "email", []any{"a@test.com"}, nil)
+		want := "SELECT `email` FROM `users` WHERE `email` IN (?)"
+		if queries[0].SQL != want {
+			t.Errorf("Query = %s, want %s", queries[0].SQL, want)
+		}
+	})
+
+	t.Run("sql server placeholders", func(t *testing.T) {
+		queries, _ := buildExistsQueries(SQLServerDialect{}, "users", "id", []any{1, 2}, nil)
+		want := "SELECT [id] FROM [users] WHERE [id] IN (@p1,@p2)"
+		if queries[0].SQL != want {
+			t.Errorf("Query = %s, want %s", queries[0].SQL, want)
+		}
+	})
+
+	t.Run("nil dialect uses default", func(t *testing.T) {
+		queries, _ := buildExistsQueries(nil, "users", "id", []any{1}, nil)
+		if queries[0].SQL != "SELECT id FROM users WHERE id IN (?)" {
+			t.Errorf("Unexpected query: %s", queries[0].SQL)
+		}
+	})
+
+	t.Run("sqlite chunks large value lists", func(t *testing.T) {
+		values := make([]any, 2500)
+		for i := range values {
+			values[i] = i
+		}
+		wheres := []WhereClause{WhereEq("tenant_id", 7)}
+
+		queries, _ := buildExistsQueries(SQLiteDialect{}, "items", "id", values, wheres)
+		if len(queries) != 3 {
+			t.Fatalf("Expected 3 chunks, got %d", len(queries))
+		}
+
+		total := 0
+		for _, q := range queries {
+			if len(q.Args) > (SQLiteDialect{}).MaxParams() {
+				t.Errorf("Chunk has %d args, exceeds limit", len(q.Args))
+			}
+			if !strings.HasSuffix(q.SQL, `AND "tenant_id" = ?`) {
+				t.Errorf("Chunk missing where clause: %s", q.SQL)
+			}
+			if q.Args[len(q.Args)-1] != 7 {
+				t.Errorf("Last arg should be where value, got %v", q.Args[len(q.Args)-1])
+			}
+			total += len(q.Args) - 1
+		}
+		if total != len(values) {
+			t.Errorf("Chunks cover %d values, want %d", total, len(values))
+		}
+	})
+
+	t.Run("postgres placeholders restart per chunk", func(t *testing.T) {
+		values := make([]any, 70000)
+		for i := range values {
+			values[i] = i
+		}
+
+		queries, _ := buildExistsQueries(PostgresDialect{}, "items", "id", values, nil)
+		if len(queries) != 2 {
+			t.Fatalf("Expected 2 chunks, got %d", len(queries))
+		}
+		if !strings.Contains(queries[1].SQL, "IN ($1,$2,") {
+			t.Error("Second chunk should restart placeholder numbering at $1")
+		}
+	})
+}
+
+// recordingSQLX captures queries for dialect-aware adapter tests
+type recordingSQLX struct {
+	queries []existsQuery
+}
+
+func (r *recordingSQLX) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	r.queries = append(r.queries, existsQuery{SQL: query, Args: args})
+	results := dest.(*[]interface{})
+	*results = append(*results, args[0])
+	return nil
+}
+
+func TestAdapterWithDialect(t *testing.T) {
+	db := &recordingSQLX{}
+	adapter := NewSQLXAdapter(db).WithDialect(SQLiteDialect{})
+
+	values := make([]any, 1500)
+	for i := range values {
+		values[i] = i
+	}
+
+	result, err := adapter.CheckExists(context.Background(), "items", "id", values, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(db.queries) != 2 {
+		t.Fatalf("Expected 2 chunked queries, got %d", len(db.queries))
+	}
+	if !strings.HasPrefix(db.queries[0].SQL, `SELECT "id" FROM "items"`) {
+		t.Errorf("Query not rendered with SQLite dialect: %s", db.queries[0].SQL)
+	}
+
+	// First value of each chunk is reported as existing
+	if !result[int64(0)] || !result[int64(999)] {
+		t.Errorf("Expected results merged across chunks, got %d entries", len(result))
+	}
+}
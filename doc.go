@@ -277,12 +277,21 @@
 //	// Bun Adapter
 //	checker := valet.NewBunAdapter(bunDB)
 //
+//	// pgx ("= ANY($1)"), document store ($in) and key-value set adapters
+//	checker := valet.NewPgxAdapter(pgxConn)
+//	checker := valet.NewDocumentAdapter(finder)
+//	checker := valet.NewKVSetAdapter(sets)
+//
 //	// Function Adapter
 //	checker := valet.FuncAdapter(func(ctx context.Context, table, column string,
 //	    values []any, wheres []valet.WhereClause) (map[any]bool, error) {
 //	    // Custom implementation
 //	})
 //
+// Select a SQL dialect for placeholders, identifier quoting and chunking:
+//
+//	checker := valet.NewSQLAdapter(db).WithDialect(valet.PostgresDialect{})
+//
 // Use with schema:
 //
 //	schema := valet.Schema{
@@ -294,6 +303,104 @@
 //
 //	err := valet.ValidateWithDB(ctx, data, schema, checker)
 //
+// Payload values and DB results are compared through NormalizeDBKey, so
+// float64(5), int64(5) and []byte("5") all match. Use Options.DBCaseInsensitive
+// for case-insensitive collations and IgnoreWhere to skip the updated row:
+//
+//	valet.String().Unique("users", "email", nil).IgnoreWhere("id", currentID)
+//
+// DB checks run only when all fields pass local validation. Set
+// Options.DBCheckMode to DBCheckPerField to also check fields whose own rules
+// passed while other fields failed.
+//
+// Wrap a checker in NewCachingChecker to cache results of reference tables with
+// a TTL and size bound; Unique checks bypass the cache:
+//
+//	checker := valet.NewCachingChecker(valet.NewSQLAdapter(db)).WithTTL(5 * time.Minute)
+//
+// Options.DBConcurrency, DBQueryTimeout, DBRetry and DBCircuitBreaker bound
+// parallel queries, time out each attempt, retry transient errors with backoff
+// and fail fast while the database is down.
+//
+// NewMemoryChecker provides an in-memory DBChecker with fixtures, SQL where
+// semantics, query recording and simulated latency and errors for tests.
+//
+// ExistsQuery (an SQL template with {values} or {value}) and ExistsFunc (a
+// batch callback) cover checks that are not a plain column lookup.
+//
+// ExistsAndLoad rules also load the matched rows; ValidateAndLoad returns them
+// keyed by field path when the checker implements DBLoader.
+//
+// Options.DBScope merges clauses such as tenant_id = ? into every check;
+// rules opt out with Unscoped(). ExistsQuery and ExistsFunc rules cannot be
+// scoped automatically and fail with ErrScopeNotApplied unless they apply the
+// scope themselves (DBScopeWhere) and are marked Unscoped().
+//
+// Array().Exists and Array().UniqueInDB check every element; add
+// SummarizeDBErrors() to report one error on the array ("all 3 tag_ids must
+// exist") instead of one per index.
+//
+// Plan returns the batched checks (and their SQL) a payload would trigger
+// without running them.
+//
+// A failing DBChecker is not reported as invalid input. By default
+// (DBErrorFailClosed) ValidateWithDBContext returns an error matching
+// ErrDBCheckFailed and Validate sets ValidationError.DBError. Set
+// Options.DBErrorPolicy to DBErrorFailOpen to skip failed checks, or to
+// DBErrorAsFieldErrors to add a generic Options.DBErrorMessage per field:
+//
+//	_, err := valet.ValidateWithDBContext(ctx, data, schema, valet.Options{DBChecker: checker})
+//	if errors.Is(err, valet.ErrDBCheckFailed) {
+//	    // 503 Service Unavailable
+//	}
+//
+// # Hooks
+//
+// Options.Hooks receives validation start/end, per-field, DB batch and custom
+// function events for tracing and metrics; embed NopHooks to implement only
+// the callbacks you need.
+//
+// # Explain
+//
+// Explain validates like Validate and returns a rule-by-rule trace (rules run,
+// parameters, outcomes and skip reasons) renderable as text or JSON.
+//
+// # Describe
+//
+// All validators implement Describer; Describe and DescribeSchema return a
+// read-only description of the configured rules for exporters, docs and
+// linting. ToJSONSchema exports a schema as JSON Schema (draft 2020-12);
+// OpenAPIComponents and OpenAPIOperation generate OpenAPI 3.1 component
+// schemas, request bodies and 422 error responses. FromJSONSchema builds a
+// Schema from a JSON Schema document and reports the keywords it cannot
+// enforce. ToZod and ToTypeScript generate Zod schemas and TypeScript
+// interfaces for frontends, marking server-only rules in comments.
+//
+// # Declarative Schemas
+//
+// LoadSchema reads schemas stored as JSON, and Schema implements
+// json.Marshaler and json.Unmarshaler in the same format. Validators with Go
+// code (Custom, RequiredIf, ...) are registered with RegisterValidator and
+// ExistsQuery templates with RegisterQuery, then referenced by name:
+//
+//	{"email": {"type": "string", "required": true, "rules": {"email": true}}, "slug": {"ref": "slug"}}
+//
+// # Rule Strings
+//
+// Rules parses Laravel-style rule strings into validators, and MustRules
+// panics on invalid ones for use in schema literals:
+//
+//	"email": valet.MustRules("required|string|max:255|email|unique:users,email")
+//
+// # HTTP
+//
+// The valethttp subpackage decodes JSON, form and multipart request bodies
+// (uploaded files become *multipart.FileHeader values) within a size limit
+// and validates them with the request context. Its Middleware stores the
+// validated data for DataFromContext and writes 422, 413 or 503 responses:
+//
+//	mux.Handle("/users", valethttp.Middleware(schema, valethttp.Options{Validation: valet.Options{DBChecker: checker}})(handler))
+//
 // # Where Clauses
 //
 // Add conditions to database checks:
@@ -301,6 +408,15 @@
 //	valet.WhereEq("status", "active")    // status = 'active'
 //	valet.WhereNot("deleted", true)      // deleted != true
 //	valet.Where("stock", ">", 0)         // stock > 0
+//	valet.WhereIn("role", "a", "b")      // role IN ('a', 'b')
+//	valet.WhereNull("deleted_at")        // deleted_at IS NULL
+//	valet.WhereLike("email", "%@x.com")  // email LIKE '%@x.com'
+//	valet.WhereBetween("age", 18, 65)    // age BETWEEN 18 AND 65
+//	valet.WhereOr(a, b)                  // (a OR b)
+//	valet.WhereField("country_id", "=", ".country_id") // compare to a payload field
+//
+// Identifiers and operators are validated; unsafe input is rejected with
+// ErrInvalidIdentifier or ErrInvalidOperator.
 //
 // # Performance
 //
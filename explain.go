@@ -0,0 +1,495 @@
+package valet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Outcome is the result of a single rule in an Explanation
+type Outcome string
+
+const (
+	OutcomePassed  Outcome = "passed"
+	OutcomeFailed  Outcome = "failed"
+	OutcomeSkipped Outcome = "skipped"
+	OutcomeApplied Outcome = "applied" // Transforms and defaults
+)
+
+// Reasons recorded when a field's rules are skipped
+const (
+	skipNullable = "value is nil and nullable"
+	skipOptional = "optional value is empty"
+	skipNoDB     = "DB checks did not run"
+	skipNoDBLoc  = "local validation failed"
+	skipNoDBConn = "no DBChecker configured"
+	skipNotRun   = "rule did not run"
+)
+
+// skipReason describes why an absent or empty value that is not required
+// skipped the remaining rules
+func skipReason(state string, requiredIf, requiredUnless func(DataObject) bool) string {
+	switch {
+	case requiredIf != nil:
+		return "value is " + state + " and the RequiredIf condition is false"
+	case requiredUnless != nil:
+		return "value is " + state + " and the RequiredUnless condition is true"
+	}
+	return "value is " + state + " and not required"
+}
+
+// Explanation is the rule-by-rule trace returned by Explain
+type Explanation struct {
+	Fields []*FieldTrace       `json:"fields"`
+	Errors map[string][]string `json:"errors,omitempty"`
+	// DBError is set when a DBChecker failed; it wraps ErrDBCheckFailed
+	DBError error `json:"-"`
+	Passed  bool  `json:"passed"`
+}
+
+// FieldTrace is the evaluation of one field path. Children hold nested
+// object fields and array elements.
+type FieldTrace struct {
+	Path     string        `json:"path"`
+	Type     string        `json:"type"` // Validator kind (e.g., "string")
+	Value    any           `json:"value"`
+	Passed   bool          `json:"passed"` // False when this path or a nested path failed
+	Rules    []RuleTrace   `json:"rules"`
+	Children []*FieldTrace `json:"children,omitempty"`
+}
+
+// RuleTrace is the evaluation of a single rule
+type RuleTrace struct {
+	Rule     string   `json:"rule"`
+	Param    any      `json:"param,omitempty"`
+	Outcome  Outcome  `json:"outcome"`
+	Messages []string `json:"messages,omitempty"`
+	Reason   string   `json:"reason,omitempty"` // Why the rule was skipped
+}
+
+// ruleDesc describes a configured rule for Explain
+type ruleDesc struct {
+	name      string // Rule name used for failures and messages
+	label     string // Display name when it differs from name (e.g., "requiredIf")
+	param     any
+	transform bool // Changes the value instead of checking it
+	whenNil   bool // Only applies to nil values (Default)
+	db        bool // Checked by the DB phase
+}
+
+// ruleDescriber lists a validator's configured rules in evaluation order
+type ruleDescriber interface {
+	describeRules() []ruleDesc
+}
+
+// requiredRules describes Required, RequiredIf and RequiredUnless
+func requiredRules(required bool, requiredIf, requiredUnless func(DataObject) bool) []ruleDesc {
+	var rules []ruleDesc
+	if required {
+		rules = append(rules, ruleDesc{name: "required"})
+	}
+	if requiredIf != nil {
+		rules = append(rules, ruleDesc{name: "required", label: "requiredIf"})
+	}
+	if requiredUnless != nil {
+		rules = append(rules, ruleDesc{name: "required", label: "requiredUnless"})
+	}
+	return rules
+}
+
+// dbRuleDescs describes Exists and Unique rules, checked after local validation
+func dbRuleDescs(exists *ExistsRule, unique *UniqueRule) []ruleDesc {
+	var rules []ruleDesc
+	if exists != nil {
+		var param any
+		switch {
+		case exists.Func != nil:
+			param = "func"
+		case exists.Query != nil:
+			param = exists.Query.SQL
+		default:
+			param = exists.Table + "." + exists.Column
+		}
+		rules = append(rules, ruleDesc{name: "exists", param: param, db: true})
+	}
+	if unique != nil {
+		rules = append(rules, ruleDesc{name: "unique", param: unique.Table + "." + unique.Column, db: true})
+	}
+	return rules
+}
+
+// stopRules are rules whose failure ends the field's validation
+var stopRules = map[string]bool{"required": true, "type": true, "format": true}
+
+// Explain validates data like Validate (Options.Hooks included) and returns a
+// trace of every configured rule, in order, with its parameter and outcome:
+// rules that ran pass or fail, the others are skipped with a reason.
+// Transforms, defaults and DB checks are traced too. Paths match the keys of
+// ValidationError.Errors.
+func Explain(data DataObject, schema Schema, opts ...Options) *Explanation {
+	var options Options
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	if options.Context == nil {
+		options.Context = context.Background()
+	}
+	trace := &explainTrace{nodes: make(map[string]*FieldTrace), dbRules: make(map[string]map[string]int)}
+	options.trace = trace
+
+	// The real validation path records into the trace
+	_, verr := validate(data, schema, options)
+	var (
+		allErrors map[string][]string
+		dbErr     error
+	)
+	if verr != nil {
+		allErrors, dbErr = verr.Errors, verr.DBError
+	}
+
+	// DB rules that are still pending never ran
+	switch {
+	case options.DBChecker == nil:
+		trace.skipPendingDB(skipNoDBConn)
+	case len(allErrors) > 0:
+		trace.skipPendingDB(skipNoDBLoc)
+	}
+
+	return &Explanation{
+		Fields:  trace.tree(),
+		Errors:  allErrors,
+		DBError: dbErr,
+		Passed:  len(allErrors) == 0 && dbErr == nil,
+	}
+}
+
+// explainTrace collects field traces by path while validating
+type explainTrace struct {
+	mu      sync.Mutex
+	nodes   map[string]*FieldTrace
+	dbRules map[string]map[string]int // Path -> rule name -> index in Rules
+}
+
+// addField merges the validator's configured rules with what was recorded
+func (t *explainTrace) addField(path string, validator Validator, value any, rec *fieldRecorder, errs map[string][]string) {
+	node := &FieldTrace{
+		Path:   path,
+		Type:   validatorKind(validator),
+		Value:  value,
+		Passed: len(errs) == 0,
+	}
+
+	var descs []ruleDesc
+	if d, ok := validator.(ruleDescriber); ok {
+		descs = d.describeRules()
+	}
+
+	failures := make(map[string][]ruleFailure, len(rec.failures))
+	for _, f := range rec.failures {
+		failures[f.rule] = append(failures[f.rule], f)
+	}
+
+	// Skips recorded for a present (e.g., empty) value happen after the type
+	// check and transforms
+	var dbRules map[string]int
+	stopped := ""
+	if value == nil {
+		stopped = rec.skipped
+	}
+	for _, d := range descs {
+		rt := RuleTrace{Rule: d.name, Param: copyParam(d.param)}
+		if d.label != "" {
+			rt.Rule = d.label
+		}
+
+		switch fails := failures[d.name]; {
+		case len(fails) > 0 && !d.db:
+			delete(failures, d.name)
+			rt.Outcome = OutcomeFailed
+			for _, f := range fails {
+				rt.Messages = append(rt.Messages, f.message)
+			}
+			if stopRules[d.name] && stopped == "" {
+				stopped = d.name + " failed"
+			}
+		case d.whenNil && value != nil:
+			rt.Outcome, rt.Reason = OutcomeSkipped, "value is present"
+		case d.whenNil && rec.skipped == "":
+			rt.Outcome = OutcomeApplied
+		case stopped != "":
+			rt.Outcome, rt.Reason = OutcomeSkipped, stopped
+		case d.transform:
+			rt.Outcome = OutcomeApplied
+		case rec.skipped != "" && d.name != "type":
+			rt.Outcome, rt.Reason = OutcomeSkipped, rec.skipped
+		case d.db:
+			// Set by applyDBResults when the checks run
+			rt.Outcome, rt.Reason = OutcomeSkipped, skipNoDB
+			if dbRules == nil {
+				dbRules = make(map[string]int)
+			}
+			dbRules[d.name] = len(node.Rules)
+		case !rec.entered && !stopRules[d.name]:
+			rt.Outcome, rt.Reason = OutcomeSkipped, skipNotRun
+		default:
+			rt.Outcome = OutcomePassed
+		}
+		node.Rules = append(node.Rules, rt)
+	}
+
+	// Failures of rules the validator does not describe, in recorded order
+	for _, f := range rec.failures {
+		fails, ok := failures[f.rule]
+		if !ok {
+			continue
+		}
+		delete(failures, f.rule)
+		rt := RuleTrace{Rule: f.rule, Param: copyParam(f.param), Outcome: OutcomeFailed}
+		for _, ff := range fails {
+			rt.Messages = append(rt.Messages, ff.message)
+		}
+		node.Rules = append(node.Rules, rt)
+	}
+
+	t.mu.Lock()
+	t.nodes[path] = node
+	if dbRules != nil {
+		t.dbRules[path] = dbRules
+	}
+	t.mu.Unlock()
+}
+
+// applyDBResults sets the outcome of DB rules from the executed checks
+func (t *explainTrace) applyDBResults(checks []DBCheck, dbErrors map[string][]string, dbErr error) {
+	failedFields := make(map[string]error)
+	var checkErr *DBCheckError
+	for _, err := range unwrapJoined(dbErr) {
+		if errors.As(err, &checkErr) {
+			for _, f := range checkErr.Fields {
+				failedFields[f] = checkErr.Err
+			}
+		}
+	}
+
+	reported := make(map[string]bool)
+	for _, check := range checks {
+		name := "exists"
+		if check.IsUnique {
+			name = "unique"
+		}
+		rt := t.dbRule(check.Field, name)
+		if rt == nil {
+			continue
+		}
+
+		var msgs []string
+		for _, p := range []string{check.Field, check.SummaryPath} {
+			if p != "" && !reported[p] {
+				msgs = append(msgs, dbErrors[p]...)
+			}
+		}
+
+		switch {
+		case len(msgs) > 0:
+			rt.Outcome, rt.Reason = OutcomeFailed, ""
+			rt.Messages = append(rt.Messages, msgs...)
+			reported[check.Field] = true
+			if check.SummaryPath != "" {
+				reported[check.SummaryPath] = true
+			}
+		case failedFields[check.Field] != nil:
+			if rt.Outcome != OutcomeFailed {
+				rt.Outcome, rt.Reason = OutcomeSkipped, "DB check failed: "+failedFields[check.Field].Error()
+			}
+		case rt.Reason == skipNoDB:
+			rt.Outcome, rt.Reason = OutcomePassed, ""
+		}
+	}
+
+	for path := range dbErrors {
+		t.markFailed(path)
+	}
+}
+
+// skipPendingDB sets the reason of DB rules that did not run
+func (t *explainTrace) skipPendingDB(reason string) {
+	for path, rules := range t.dbRules {
+		for _, i := range rules {
+			if rt := &t.nodes[path].Rules[i]; rt.Reason == skipNoDB {
+				rt.Reason = reason
+			}
+		}
+	}
+}
+
+// dbRule finds the DB rule of the nearest traced path
+func (t *explainTrace) dbRule(path, name string) *RuleTrace {
+	for {
+		if i, ok := t.dbRules[path][name]; ok {
+			return &t.nodes[path].Rules[i]
+		}
+		i := strings.LastIndexByte(path, '.')
+		if i < 0 {
+			return nil
+		}
+		path = path[:i]
+	}
+}
+
+// markFailed marks a path and its parents as failed
+func (t *explainTrace) markFailed(path string) {
+	for {
+		if node := t.nodes[path]; node != nil {
+			node.Passed = false
+		}
+		i := strings.LastIndexByte(path, '.')
+		if i < 0 {
+			return
+		}
+		path = path[:i]
+	}
+}
+
+// tree links the traced paths into a tree sorted by path
+func (t *explainTrace) tree() []*FieldTrace {
+	paths := make([]string, 0, len(t.nodes))
+	for path := range t.nodes {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool { return comparePaths(paths[i], paths[j]) })
+
+	var roots []*FieldTrace
+	for _, path := range paths {
+		node := t.nodes[path]
+		if parent := t.parent(path); parent != nil {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
+
+// parent returns the nearest traced ancestor of path
+func (t *explainTrace) parent(path string) *FieldTrace {
+	for {
+		i := strings.LastIndexByte(path, '.')
+		if i < 0 {
+			return nil
+		}
+		path = path[:i]
+		if node := t.nodes[path]; node != nil {
+			return node
+		}
+	}
+}
+
+// comparePaths orders paths segment by segment, array indexes numerically
+func comparePaths(a, b string) bool {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] == bs[i] {
+			continue
+		}
+		ai, aErr := strconv.Atoi(as[i])
+		bi, bErr := strconv.Atoi(bs[i])
+		if aErr == nil && bErr == nil {
+			return ai < bi
+		}
+		return as[i] < bs[i]
+	}
+	return len(as) < len(bs)
+}
+
+// unwrapJoined flattens errors joined with errors.Join
+func unwrapJoined(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+// validatorKind returns a short name for a validator (e.g., "string", "number[int]")
+func validatorKind(v Validator) string {
+	name := fmt.Sprintf("%T", v)
+	name = strings.TrimPrefix(name, "*")
+	name = strings.TrimPrefix(name, "valet.")
+	name = strings.Replace(name, "Validator", "", 1)
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// JSON returns the explanation as indented JSON
+func (e *Explanation) JSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// String renders the explanation as an indented text tree
+func (e *Explanation) String() string {
+	var sb strings.Builder
+	for _, f := range e.Fields {
+		writeFieldTrace(&sb, f, 0)
+	}
+	if e.DBError != nil {
+		fmt.Fprintf(&sb, "DB error: %v\n", e.DBError)
+	}
+	if e.Passed {
+		sb.WriteString("result: passed\n")
+	} else {
+		sb.WriteString("result: failed\n")
+	}
+	return sb.String()
+}
+
+func writeFieldTrace(sb *strings.Builder, f *FieldTrace, depth int) {
+	indent := strings.Repeat("  ", depth)
+	status := "ok"
+	if !f.Passed {
+		status = "FAIL"
+	}
+	fmt.Fprintf(sb, "%s%s (%s) = %s [%s]\n", indent, f.Path, f.Type, formatTraceValue(f.Value), status)
+
+	for _, r := range f.Rules {
+		fmt.Fprintf(sb, "%s  - %s", indent, r.Rule)
+		if r.Param != nil {
+			fmt.Fprintf(sb, "(%v)", r.Param)
+		}
+		fmt.Fprintf(sb, ": %s", r.Outcome)
+		if r.Reason != "" {
+			fmt.Fprintf(sb, " (%s)", r.Reason)
+		}
+		for _, m := range r.Messages {
+			fmt.Fprintf(sb, " %q", m)
+		}
+		sb.WriteByte('\n')
+	}
+
+	for _, c := range f.Children {
+		writeFieldTrace(sb, c, depth+1)
+	}
+}
+
+// formatTraceValue renders scalars as-is and collections by size
+func formatTraceValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(val)
+	case []any:
+		return fmt.Sprintf("[%d items]", len(val))
+	case map[string]any:
+		return fmt.Sprintf("{%d keys}", len(val))
+	}
+	return fmt.Sprintf("%v", v)
+}
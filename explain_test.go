@@ -0,0 +1,246 @@
+package valet
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// findTrace returns the trace of a path from an explanation tree
+func findTrace(fields []*FieldTrace, path string) *FieldTrace {
+	for _, f := range fields {
+		if f.Path == path {
+			return f
+		}
+		if found := findTrace(f.Children, path); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// ruleOutcomes returns "rule=outcome" pairs in order
+func ruleOutcomes(f *FieldTrace) []string {
+	out := make([]string, len(f.Rules))
+	for i, r := range f.Rules {
+		out[i] = r.Rule + "=" + string(r.Outcome)
+	}
+	return out
+}
+
+func assertOutcomes(t *testing.T, e *Explanation, path string, want ...string) *FieldTrace {
+	t.Helper()
+	f := findTrace(e.Fields, path)
+	if f == nil {
+		t.Fatalf("No trace for %s", path)
+	}
+	if got := ruleOutcomes(f); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("%s rules = %v, want %v", path, got, want)
+	}
+	return f
+}
+
+func TestExplain_RuleOrderAndOutcomes(t *testing.T) {
+	schema := Schema{
+		"name":  String().Required().Trim().Min(3).Alpha(),
+		"email": String().Required().Email(),
+		"age":   Float().Default(18).Min(18),
+	}
+	data := DataObject{"name": " a1 ", "email": "a@example.com"}
+
+	e := Explain(data, schema)
+	if e.Passed {
+		t.Fatal("Expected failure")
+	}
+
+	name := assertOutcomes(t, e, "name", "required=passed", "type=passed", "trim=applied", "min=failed", "alpha=failed")
+	if name.Passed || name.Rules[3].Param != 3 || len(name.Rules[3].Messages) != 1 {
+		t.Errorf("Unexpected name trace: %+v", name)
+	}
+	assertOutcomes(t, e, "email", "required=passed", "type=passed", "email=passed")
+	assertOutcomes(t, e, "age", "default=applied", "type=passed", "min=passed")
+
+	// Errors match Validate
+	verr := Validate(data, schema)
+	if len(verr.Errors) != len(e.Errors) || len(e.Errors["name"]) != 2 {
+		t.Errorf("Errors = %v, Validate = %v", e.Errors, verr.Errors)
+	}
+}
+
+func TestExplain_SkippedRules(t *testing.T) {
+	isDE := func(d DataObject) bool { return d["country"] == "DE" }
+	schema := Schema{
+		"nick":  String().Nullable().Min(2),
+		"vat":   String().RequiredIf(isDE).Min(5),
+		"note":  String().Min(5),
+		"code":  String().Required().Min(2),
+		"count": Float().Required().Min(1),
+		"opt":   Optional(String().Min(3)),
+	}
+	data := DataObject{"note": "", "count": "x"}
+
+	e := Explain(data, schema)
+
+	nick := assertOutcomes(t, e, "nick", "type=skipped", "min=skipped")
+	if nick.Rules[0].Reason != skipNullable {
+		t.Errorf("nick reason = %q", nick.Rules[0].Reason)
+	}
+	vat := assertOutcomes(t, e, "vat", "requiredIf=skipped", "type=skipped", "min=skipped")
+	if !strings.Contains(vat.Rules[0].Reason, "RequiredIf condition is false") {
+		t.Errorf("vat reason = %q", vat.Rules[0].Reason)
+	}
+	note := assertOutcomes(t, e, "note", "type=passed", "min=skipped")
+	if note.Rules[1].Reason != "value is empty and not required" {
+		t.Errorf("note reason = %q", note.Rules[1].Reason)
+	}
+	code := assertOutcomes(t, e, "code", "required=failed", "type=skipped", "min=skipped")
+	if code.Rules[1].Reason != "required failed" {
+		t.Errorf("code reason = %q", code.Rules[1].Reason)
+	}
+	assertOutcomes(t, e, "count", "required=passed", "type=failed", "min=skipped")
+	opt := assertOutcomes(t, e, "opt", "type=skipped", "min=skipped")
+	if opt.Rules[0].Reason != skipOptional {
+		t.Errorf("opt reason = %q", opt.Rules[0].Reason)
+	}
+}
+
+func TestExplain_RulesThatDidNotRun(t *testing.T) {
+	trace := &explainTrace{nodes: make(map[string]*FieldTrace), dbRules: make(map[string]map[string]int)}
+
+	// A validator that returned before its rules without recording a reason
+	trace.addField("name", String().Required().Min(3), "ab", &fieldRecorder{}, nil)
+	trace.addField("nick", String().Required().Min(3), "abc", &fieldRecorder{entered: true}, nil)
+
+	name := trace.nodes["name"]
+	if got := ruleOutcomes(name); strings.Join(got, ",") != "required=passed,type=passed,min=skipped" || name.Rules[2].Reason != skipNotRun {
+		t.Errorf("name rules = %+v", name.Rules)
+	}
+	if got := ruleOutcomes(trace.nodes["nick"]); strings.Join(got, ",") != "required=passed,type=passed,min=passed" {
+		t.Errorf("nick rules = %v", got)
+	}
+}
+
+func TestExplain_UsesHooks(t *testing.T) {
+	hooks := newRecordingHooks()
+	e := Explain(DataObject{"name": "a"}, Schema{"name": String().Min(3)}, Options{Hooks: hooks})
+	if e.Passed || len(hooks.starts) != 1 || len(hooks.ends) != 1 || hooks.ends[0].Passed {
+		t.Errorf("Expected one failed validation run, got %+v", hooks.ends)
+	}
+	if name := hooks.fields["name"]; len(name.Rules) != 1 || name.Rules[0] != "min" {
+		t.Errorf("name event = %+v", name)
+	}
+}
+
+func TestExplain_NestedPaths(t *testing.T) {
+	schema := Schema{
+		"address": Object().Shape(Schema{
+			"city": String().Required(),
+		}),
+		"tags": Array().Min(1).Unique().Of(String().Min(2)),
+	}
+	data := DataObject{
+		"address": map[string]any{},
+		"tags":    []any{"ok", "x", "ok"},
+	}
+
+	e := Explain(data, schema)
+
+	address := findTrace(e.Fields, "address")
+	if address == nil || address.Passed || len(address.Children) != 1 || address.Children[0].Path != "address.city" {
+		t.Fatalf("Unexpected address trace: %+v", address)
+	}
+	assertOutcomes(t, e, "address.city", "required=failed", "type=skipped")
+
+	tags := assertOutcomes(t, e, "tags", "type=passed", "min=passed", "unique=failed")
+	if len(tags.Children) != 3 || tags.Children[0].Path != "tags.0" || tags.Children[2].Path != "tags.2" {
+		t.Fatalf("Unexpected tags children: %+v", tags.Children)
+	}
+	assertOutcomes(t, e, "tags.1", "type=passed", "min=failed")
+
+	// Every error path is traced
+	for path := range e.Errors {
+		if findTrace(e.Fields, path) == nil {
+			t.Errorf("No trace for error path %s", path)
+		}
+	}
+}
+
+func TestExplain_UnionTracesMatchedBranch(t *testing.T) {
+	card := Object().Shape(Schema{"number": String().Required().Length(16)})
+	bank := Object().Shape(Schema{"iban": String().Required()})
+	schema := Schema{"payment": Union(card, bank)}
+
+	e := Explain(DataObject{"payment": map[string]any{"number": "4242", "iban": "DE89"}}, schema)
+	if !e.Passed {
+		t.Fatalf("Expected pass, got %v", e.Errors)
+	}
+	if findTrace(e.Fields, "payment.number") != nil {
+		t.Error("Failed union branch was traced")
+	}
+	assertOutcomes(t, e, "payment.iban", "required=passed", "type=passed")
+}
+
+func TestExplain_DBRules(t *testing.T) {
+	checker := NewMemoryChecker(map[string][]map[string]any{
+		"users": {{"id": 1, "email": "taken@example.com"}},
+	})
+	schema := Schema{
+		"user_id":  Float().Required().Exists("users", "id"),
+		"owner_id": Float().Required().Exists("users", "id"),
+		"email":    String().Required().Unique("users", "email", nil),
+	}
+	data := DataObject{"user_id": float64(1), "owner_id": float64(2), "email": "new@example.com"}
+
+	e := Explain(data, schema, Options{DBChecker: checker})
+	assertOutcomes(t, e, "user_id", "required=passed", "type=passed", "exists=passed")
+	owner := assertOutcomes(t, e, "owner_id", "required=passed", "type=passed", "exists=failed")
+	if owner.Passed || len(owner.Rules[2].Messages) != 1 || owner.Rules[2].Param != "users.id" {
+		t.Errorf("Unexpected owner_id trace: %+v", owner)
+	}
+	assertOutcomes(t, e, "email", "required=passed", "type=passed", "unique=passed")
+
+	e = Explain(data, schema)
+	user := assertOutcomes(t, e, "user_id", "required=passed", "type=passed", "exists=skipped")
+	if user.Rules[2].Reason != skipNoDBConn {
+		t.Errorf("Reason = %q", user.Rules[2].Reason)
+	}
+
+	e = Explain(DataObject{"user_id": float64(1)}, schema, Options{DBChecker: checker})
+	user = assertOutcomes(t, e, "user_id", "required=passed", "type=passed", "exists=skipped")
+	if user.Rules[2].Reason != skipNoDBLoc {
+		t.Errorf("Reason = %q", user.Rules[2].Reason)
+	}
+}
+
+func TestExplain_TextAndJSON(t *testing.T) {
+	schema := Schema{"name": String().Required().Min(3)}
+	e := Explain(DataObject{"name": "ab"}, schema)
+
+	text := e.String()
+	for _, want := range []string{`name (string) = "ab" [FAIL]`, "- min(3): failed", "result: failed"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Text missing %q:\n%s", want, text)
+		}
+	}
+
+	b, err := e.JSON()
+	if err != nil {
+		t.Fatalf("JSON error: %v", err)
+	}
+	var decoded struct {
+		Fields []struct {
+			Path  string `json:"path"`
+			Rules []struct {
+				Rule    string `json:"rule"`
+				Outcome string `json:"outcome"`
+			} `json:"rules"`
+		} `json:"fields"`
+		Passed bool `json:"passed"`
+	}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if decoded.Passed || len(decoded.Fields) != 1 || decoded.Fields[0].Rules[2].Outcome != "failed" {
+		t.Errorf("Unexpected JSON: %s", b)
+	}
+}
@@ -202,11 +202,13 @@ func (v *FileValidator) Validate(ctx *ValidationContext, value any) map[string][
 		Index: extractIndex(fieldPath),
 		Value: value,
 		Data:  DataAccessor(ctx.RootData),
+		rec:   ctx.rec,
 	}
 
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.required {
@@ -224,6 +226,7 @@ func (v *FileValidator) Validate(ctx *ValidationContext, value any) map[string][
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		}
+		ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 		return nil
 	}
 
@@ -240,6 +243,8 @@ func (v *FileValidator) Validate(ctx *ValidationContext, value any) map[string][
 		return errors
 	}
 
+	ctx.enterRules()
+
 	// Min size
 	if v.minSet && file.Size < v.min {
 		msgCtx.Rule = "min"
@@ -375,7 +380,7 @@ func (v *FileValidator) Validate(ctx *ValidationContext, value any) map[string][
 		lookup := func(path string) LookupResult {
 			return lookupPath(ctx.RootData, path)
 		}
-		if err := v.customFn(file, lookup); err != nil {
+		if err := ctx.runCustom(func() error { return v.customFn(file, lookup) }); err != nil {
 			msgCtx.Rule = "custom"
 			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error(), msgCtx))
 		}
@@ -388,10 +393,45 @@ func (v *FileValidator) Validate(ctx *ValidationContext, value any) map[string][
 }
 
 func (v *FileValidator) msg(rule, defaultMsg string, msgCtx MessageContext) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return resolveMessage(msg, msgCtx)
+		message = resolveMessage(msg, msgCtx)
+	}
+	msgCtx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *FileValidator) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	rules = append(rules, ruleDesc{name: "type", param: "file"})
+	if v.minSet {
+		rules = append(rules, ruleDesc{name: "min", param: v.min})
+	}
+	if v.maxSet {
+		rules = append(rules, ruleDesc{name: "max", param: v.max})
+	}
+	if len(v.mimes) > 0 {
+		rules = append(rules, ruleDesc{name: "mimes", param: v.mimes})
+	}
+	if len(v.extensions) > 0 {
+		rules = append(rules, ruleDesc{name: "extensions", param: v.extensions})
 	}
-	return defaultMsg
+	if v.image {
+		rules = append(rules, ruleDesc{name: "image"})
+	}
+	if v.dimensions != nil {
+		rules = append(rules, ruleDesc{name: "dimensions", param: *v.dimensions})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	return rules
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *FileValidator) Describe() Description {
+	return newDescription("file", v.nullable, v.describeRules(), v.messages)
 }
 
 // Helper functions
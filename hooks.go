@@ -0,0 +1,230 @@
+package valet
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Hooks receives validation lifecycle events so tracing and metrics can be
+// plugged in without a telemetry dependency. Embed NopHooks to implement only
+// the callbacks you need. Callbacks may run concurrently (parallel DB batches,
+// Array().Concurrent elements) and should not block.
+type Hooks interface {
+	// OnValidationStart is called before any field is validated. The returned
+	// context (e.g., carrying a span) is passed to the other callbacks and to
+	// the DBChecker; returning nil keeps the original context.
+	OnValidationStart(ctx context.Context, e ValidationStartEvent) context.Context
+	// OnValidationEnd is called once DB checks have run
+	OnValidationEnd(ctx context.Context, e ValidationEndEvent)
+	// OnField is called after each field (including nested object fields and
+	// array elements) is validated locally
+	OnField(ctx context.Context, e FieldEvent)
+	// OnDBBatch is called once per batched DB query group, after any retries
+	OnDBBatch(ctx context.Context, e DBBatchEvent)
+	// OnCustomFunc is called after each Custom validation function
+	OnCustomFunc(ctx context.Context, e CustomFuncEvent)
+}
+
+// ValidationStartEvent describes a validation run
+type ValidationStartEvent struct {
+	Fields int // Number of top-level schema fields
+}
+
+// ValidationEndEvent describes the outcome of a validation run
+type ValidationEndEvent struct {
+	Duration time.Duration
+	Errors   int   // Number of field paths with errors
+	DBError  error // DBChecker failure, if any
+	Passed   bool
+}
+
+// FieldEvent describes the local validation of a single field path
+type FieldEvent struct {
+	Path     string
+	Rules    []string // Rules that failed on this path (e.g., "required", "min")
+	Duration time.Duration
+	Passed   bool // False when this path or any nested path failed
+}
+
+// DBBatchEvent describes a single batched DB query
+type DBBatchEvent struct {
+	Table    string // Empty for ExistsQuery and ExistsFunc batches
+	Column   string
+	Values   int
+	Unique   bool
+	Duration time.Duration // Total time of all attempts, including backoff
+	Attempts int           // Queries run; 0 when the circuit breaker was open
+	Err      error         // Error of the last attempt
+}
+
+// CustomFuncEvent describes a Custom validation function call
+type CustomFuncEvent struct {
+	Path     string
+	Duration time.Duration
+	Err      error
+}
+
+// NopHooks implements Hooks with no-op callbacks
+type NopHooks struct{}
+
+func (NopHooks) OnValidationStart(ctx context.Context, _ ValidationStartEvent) context.Context {
+	return ctx
+}
+func (NopHooks) OnValidationEnd(context.Context, ValidationEndEvent) {}
+func (NopHooks) OnField(context.Context, FieldEvent)                 {}
+func (NopHooks) OnDBBatch(context.Context, DBBatchEvent)             {}
+func (NopHooks) OnCustomFunc(context.Context, CustomFuncEvent)       {}
+
+// hooks returns the configured hooks, or nil
+func (ctx *ValidationContext) hooks() Hooks {
+	if ctx.Options == nil {
+		return nil
+	}
+	return ctx.Options.Hooks
+}
+
+// fieldRecorder collects what happened while validating one field path
+type fieldRecorder struct {
+	failures []ruleFailure
+	skipped  string // Why the remaining rules did not run (e.g., "nullable")
+	entered  bool   // The value passed the presence and type checks
+}
+
+// ruleFailure is a failed rule with its resolved message
+type ruleFailure struct {
+	rule    string
+	path    string
+	param   any
+	message string
+}
+
+// failedRules returns the names of the failed rules in order
+func (r *fieldRecorder) failedRules() []string {
+	if len(r.failures) == 0 {
+		return nil
+	}
+	rules := make([]string, len(r.failures))
+	for i, f := range r.failures {
+		rules[i] = f.rule
+	}
+	return rules
+}
+
+// trace returns the Explain trace, or nil
+func (ctx *ValidationContext) trace() *explainTrace {
+	if ctx.Options == nil {
+		return nil
+	}
+	return ctx.Options.trace
+}
+
+// reporting reports whether fields are recorded for Hooks or Explain
+func (ctx *ValidationContext) reporting() bool {
+	return ctx.hooks() != nil || ctx.trace() != nil
+}
+
+// deferredReports buffers the Hooks and Explain reports of a union branch
+// until it is known to match. Concurrent array elements report in parallel.
+type deferredReports struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// report runs fn now, or buffers it while a union branch is being tried
+func (ctx *ValidationContext) report(fn func()) {
+	if ctx.reports == nil {
+		fn()
+		return
+	}
+	ctx.reports.mu.Lock()
+	ctx.reports.fns = append(ctx.reports.fns, fn)
+	ctx.reports.mu.Unlock()
+}
+
+// commit reports the buffered events of a matched branch, into the enclosing
+// branch's buffer when unions are nested
+func (r *deferredReports) commit(ctx *ValidationContext) {
+	for _, fn := range r.fns {
+		ctx.report(fn)
+	}
+}
+
+// validateField runs a validator and reports the result to Options.Hooks and
+// the Explain trace
+func validateField(ctx *ValidationContext, validator Validator, value any) map[string][]string {
+	if !ctx.reporting() {
+		return validator.Validate(ctx, value)
+	}
+	hooks, trace := ctx.hooks(), ctx.trace()
+
+	rec := &fieldRecorder{}
+	ctx.rec = rec
+	start := time.Now()
+	errs := validator.Validate(ctx, value)
+	duration := time.Since(start)
+
+	path, hctx := ctx.FullPath(), ctx.Ctx
+	ctx.report(func() {
+		if trace != nil {
+			trace.addField(path, validator, value, rec, errs)
+		}
+		if hooks != nil {
+			hooks.OnField(hctx, FieldEvent{
+				Path:     path,
+				Rules:    rec.failedRules(),
+				Duration: duration,
+				Passed:   len(errs) == 0,
+			})
+		}
+	})
+	return errs
+}
+
+// runCustom calls a Custom validation function and reports its duration
+func (ctx *ValidationContext) runCustom(fn func() error) error {
+	hooks := ctx.hooks()
+	if hooks == nil {
+		return fn()
+	}
+
+	start := time.Now()
+	err := fn()
+	event := CustomFuncEvent{
+		Path:     ctx.FullPath(),
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	hctx := ctx.Ctx
+	ctx.report(func() { hooks.OnCustomFunc(hctx, event) })
+	return err
+}
+
+// recordFailure notes a failed rule of the current field
+func (ctx *ValidationContext) recordFailure(rule, message string) {
+	if ctx.rec != nil {
+		ctx.rec.failures = append(ctx.rec.failures, ruleFailure{rule: rule, path: ctx.FullPath(), message: message})
+	}
+}
+
+// skip notes why the remaining rules of the current field did not run
+func (ctx *ValidationContext) skip(reason string) {
+	if ctx.rec != nil && ctx.rec.skipped == "" {
+		ctx.rec.skipped = reason
+	}
+}
+
+// enterRules notes that the value passed the presence and type checks, so
+// the remaining rules of the current field run
+func (ctx *ValidationContext) enterRules() {
+	if ctx.rec != nil {
+		ctx.rec.entered = true
+	}
+}
+
+// recordFailure notes a failed rule of the current field
+func (m MessageContext) recordFailure(rule, message string) {
+	if m.rec != nil {
+		m.rec.failures = append(m.rec.failures, ruleFailure{rule: rule, path: m.Path, param: m.Param, message: message})
+	}
+}
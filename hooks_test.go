@@ -0,0 +1,259 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+)
+
+type hookKey struct{}
+
+// recordingHooks records every event it receives
+type recordingHooks struct {
+	NopHooks
+	mu      sync.Mutex
+	starts  []ValidationStartEvent
+	ends    []ValidationEndEvent
+	fields  map[string]FieldEvent
+	batches []DBBatchEvent
+	customs []CustomFuncEvent
+	ctxOK   bool
+}
+
+func newRecordingHooks() *recordingHooks {
+	return &recordingHooks{fields: make(map[string]FieldEvent), ctxOK: true}
+}
+
+func (h *recordingHooks) OnValidationStart(ctx context.Context, e ValidationStartEvent) context.Context {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.starts = append(h.starts, e)
+	return context.WithValue(ctx, hookKey{}, "span")
+}
+
+func (h *recordingHooks) OnValidationEnd(ctx context.Context, e ValidationEndEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.check(ctx)
+	h.ends = append(h.ends, e)
+}
+
+func (h *recordingHooks) OnField(ctx context.Context, e FieldEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.check(ctx)
+	h.fields[e.Path] = e
+}
+
+func (h *recordingHooks) OnDBBatch(ctx context.Context, e DBBatchEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.check(ctx)
+	h.batches = append(h.batches, e)
+}
+
+func (h *recordingHooks) OnCustomFunc(ctx context.Context, e CustomFuncEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.check(ctx)
+	h.customs = append(h.customs, e)
+}
+
+// check verifies that the context returned by OnValidationStart is propagated
+func (h *recordingHooks) check(ctx context.Context) {
+	if ctx.Value(hookKey{}) != "span" {
+		h.ctxOK = false
+	}
+}
+
+func TestHooks_FieldEvents(t *testing.T) {
+	hooks := newRecordingHooks()
+	schema := Schema{
+		"name":  String().Required().Min(3).Alpha(),
+		"email": String().Required().Email(),
+		"address": Object().Shape(Schema{
+			"city": String().Required(),
+		}),
+		"tags": Array().Of(String().Min(2)),
+		"at":   Time().Required(),
+	}
+	data := DataObject{
+		"name":    "a1",
+		"email":   "a@example.com",
+		"address": map[string]any{},
+		"tags":    []any{"ok", "x"},
+	}
+
+	err := Validate(data, schema, Options{Hooks: hooks})
+	if err == nil {
+		t.Fatal("Expected validation errors")
+	}
+
+	if len(hooks.starts) != 1 || hooks.starts[0].Fields != 5 {
+		t.Errorf("Unexpected start events: %+v", hooks.starts)
+	}
+	if len(hooks.ends) != 1 || hooks.ends[0].Passed || hooks.ends[0].Errors != len(err.Errors) {
+		t.Errorf("Unexpected end events: %+v", hooks.ends)
+	}
+
+	name := hooks.fields["name"]
+	rules := append([]string(nil), name.Rules...)
+	sort.Strings(rules)
+	if name.Passed || len(rules) != 2 || rules[0] != "alpha" || rules[1] != "min" {
+		t.Errorf("name event = %+v", name)
+	}
+	if email := hooks.fields["email"]; !email.Passed || len(email.Rules) != 0 {
+		t.Errorf("email event = %+v", email)
+	}
+	if city := hooks.fields["address.city"]; city.Passed || len(city.Rules) != 1 || city.Rules[0] != "required" {
+		t.Errorf("address.city event = %+v", city)
+	}
+	if addr := hooks.fields["address"]; addr.Passed || len(addr.Rules) != 0 {
+		t.Errorf("address event = %+v", addr)
+	}
+	if tag := hooks.fields["tags.1"]; tag.Passed || len(tag.Rules) != 1 || tag.Rules[0] != "min" {
+		t.Errorf("tags.1 event = %+v", tag)
+	}
+	if !hooks.fields["tags.0"].Passed {
+		t.Errorf("tags.0 should pass")
+	}
+	if at := hooks.fields["at"]; at.Passed || len(at.Rules) != 1 || at.Rules[0] != "required" {
+		t.Errorf("at event = %+v", at)
+	}
+	if !hooks.ctxOK {
+		t.Error("Context from OnValidationStart was not propagated")
+	}
+}
+
+func TestHooks_UnionAttemptsAreNotRecorded(t *testing.T) {
+	hooks := newRecordingHooks()
+	schema := Schema{"id": Union(String().Min(5), Float())}
+
+	if err := Validate(DataObject{"id": float64(1)}, schema, Options{Hooks: hooks}); err != nil {
+		t.Fatalf("Unexpected error: %v", err.Errors)
+	}
+	if id := hooks.fields["id"]; !id.Passed || len(id.Rules) != 0 {
+		t.Errorf("id event = %+v", id)
+	}
+}
+
+func TestHooks_UnionAttemptsEmitNoEvents(t *testing.T) {
+	hooks := newRecordingHooks()
+	card := Object().Shape(Schema{
+		"number": String().Required().Custom(func(string, Lookup) error { return errors.New("invalid card") }),
+	})
+	bank := Object().Shape(Schema{"iban": String().Required()})
+	schema := Schema{"payment": Union(card, bank)}
+
+	data := DataObject{"payment": map[string]any{"number": "4242", "iban": "DE89"}}
+	if err := Validate(data, schema, Options{Hooks: hooks}); err != nil {
+		t.Fatalf("Unexpected error: %v", err.Errors)
+	}
+	if _, ok := hooks.fields["payment.number"]; ok {
+		t.Errorf("Failed union branch emitted a field event: %v", hooks.fields)
+	}
+	if len(hooks.customs) != 0 {
+		t.Errorf("Failed union branch emitted custom events: %+v", hooks.customs)
+	}
+	if iban, ok := hooks.fields["payment.iban"]; !ok || !iban.Passed {
+		t.Errorf("Expected the matched branch to be reported, got %v", hooks.fields)
+	}
+}
+
+func TestHooks_UnionMatchedBranchRunsOnce(t *testing.T) {
+	hooks := newRecordingHooks()
+	calls := 0
+	bank := Object().Shape(Schema{
+		"iban": String().Required().Custom(func(string, Lookup) error {
+			calls++
+			return nil
+		}),
+	})
+	schema := Schema{"payment": Union(String(), bank)}
+
+	data := DataObject{"payment": map[string]any{"iban": "DE89"}}
+	if err := Validate(data, schema, Options{Hooks: hooks}); err != nil {
+		t.Fatalf("Unexpected error: %v", err.Errors)
+	}
+	if calls != 1 {
+		t.Errorf("Expected Custom to run once with Hooks enabled, got %d", calls)
+	}
+	if len(hooks.customs) != 1 || hooks.customs[0].Path != "payment.iban" {
+		t.Errorf("Expected one custom event for the matched branch, got %+v", hooks.customs)
+	}
+	if _, ok := hooks.fields["payment.iban"]; !ok {
+		t.Errorf("Expected the matched branch to be reported, got %v", hooks.fields)
+	}
+}
+
+func TestHooks_CustomFunc(t *testing.T) {
+	hooks := newRecordingHooks()
+	schema := Schema{
+		"code": String().Custom(func(v string, _ Lookup) error {
+			return errors.New("bad code")
+		}),
+	}
+
+	_ = Validate(DataObject{"code": "x"}, schema, Options{Hooks: hooks})
+
+	if len(hooks.customs) != 1 {
+		t.Fatalf("Expected 1 custom event, got %d", len(hooks.customs))
+	}
+	if e := hooks.customs[0]; e.Path != "code" || e.Err == nil {
+		t.Errorf("Unexpected custom event: %+v", e)
+	}
+	if code := hooks.fields["code"]; len(code.Rules) != 1 || code.Rules[0] != "custom" {
+		t.Errorf("code event = %+v", code)
+	}
+}
+
+func TestHooks_DBBatch(t *testing.T) {
+	hooks := newRecordingHooks()
+	checker := NewMemoryChecker(map[string][]map[string]any{
+		"users": {{"id": 1}, {"id": 2}},
+	})
+	schema := Schema{
+		"user_id":  Float().Required().Exists("users", "id"),
+		"owner_id": Float().Required().Exists("users", "id"),
+	}
+	data := DataObject{"user_id": float64(1), "owner_id": float64(3)}
+
+	err := Validate(data, schema, Options{DBChecker: checker, Hooks: hooks})
+	if err == nil || len(err.Errors["owner_id"]) == 0 {
+		t.Fatalf("Expected owner_id error, got %v", err)
+	}
+
+	if len(hooks.batches) != 1 {
+		t.Fatalf("Expected 1 batch event, got %d", len(hooks.batches))
+	}
+	b := hooks.batches[0]
+	if b.Table != "users" || b.Column != "id" || b.Values != 2 || b.Unique || b.Err != nil || b.Attempts != 1 {
+		t.Errorf("Unexpected batch event: %+v", b)
+	}
+	if !hooks.ctxOK {
+		t.Error("Context from OnValidationStart was not propagated")
+	}
+}
+
+func TestHooks_DBBatchError(t *testing.T) {
+	hooks := newRecordingHooks()
+	dbErr := errors.New("connection refused")
+	checker := NewMemoryChecker(nil).FailWith("", dbErr)
+	schema := Schema{"user_id": Float().Required().Exists("users", "id")}
+
+	retry := &RetryPolicy{MaxAttempts: 3, Retryable: func(error) bool { return true }}
+	err := Validate(DataObject{"user_id": float64(1)}, schema, Options{DBChecker: checker, Hooks: hooks, DBRetry: retry})
+	if err == nil || err.DBError == nil {
+		t.Fatalf("Expected DB error, got %v", err)
+	}
+
+	// One event per group, after all retries
+	if len(hooks.batches) != 1 || !errors.Is(hooks.batches[0].Err, dbErr) || hooks.batches[0].Attempts != 3 {
+		t.Errorf("Unexpected batch events: %+v", hooks.batches)
+	}
+	if len(hooks.ends) != 1 || hooks.ends[0].DBError == nil {
+		t.Errorf("Unexpected end events: %+v", hooks.ends)
+	}
+}
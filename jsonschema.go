@@ -0,0 +1,427 @@
+package valet
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JSONSchemaDraft is the $schema URI of exported JSON Schemas
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON Schema extension keywords for rules JSON Schema cannot express
+const (
+	// JSONSchemaExtRules lists rules that are only checked by valet, e.g.
+	// [{"rule": "sameAs", "param": "password"}, {"rule": "custom"}]
+	JSONSchemaExtRules = "x-valet-rules"
+	// JSONSchemaExtDB lists Exists and Unique rules, e.g.
+	// [{"rule": "exists", "table": "users", "column": "id"}]
+	JSONSchemaExtDB = "x-valet-db"
+	// JSONSchemaExtType is the valet type for values JSON Schema has no
+	// type for ("file", "custom")
+	JSONSchemaExtType = "x-valet-type"
+)
+
+// ToJSONSchema exports a schema as a JSON Schema (draft 2020-12) object,
+// ready for json.Marshal. Formats, lengths, bounds, patterns, enums, nested
+// shapes, unions and Optional map to standard keywords; DB checks, custom
+// functions, transforms and cross-field rules are listed under the x-valet-*
+// extension keywords. Regex patterns are exported in Go syntax.
+func ToJSONSchema(schema Schema) map[string]any {
+	out := jsonSchemaExporter{}.object(DescribeSchema(schema), false)
+	out["$schema"] = JSONSchemaDraft
+	return out
+}
+
+// jsonSchemaExporter converts descriptions to JSON Schema
+type jsonSchemaExporter struct {
+	openAPI bool // Files as OpenAPI binary strings instead of x-valet-type
+}
+
+// object converts an object shape
+func (x jsonSchemaExporter) object(fields map[string]Description, strict bool) map[string]any {
+	out := map[string]any{"type": "object"}
+	if len(fields) > 0 {
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		properties := make(map[string]any, len(fields))
+		var required []string
+		for _, name := range names {
+			properties[name] = x.field(fields[name])
+			if fields[name].Required {
+				required = append(required, name)
+			}
+		}
+		out["properties"] = properties
+		if len(required) > 0 {
+			out["required"] = required
+		}
+	}
+	if strict {
+		out["additionalProperties"] = false
+	}
+	return out
+}
+
+// field converts a single field description
+func (x jsonSchemaExporter) field(d Description) map[string]any {
+	out := map[string]any{}
+	var rules []map[string]any
+	unsupported := func(r RuleDescription) {
+		rule := map[string]any{"rule": r.Name}
+		if r.Param != nil {
+			rule["param"] = r.Param
+		}
+		rules = append(rules, rule)
+	}
+
+	switch d.Type {
+	case "string":
+		out["type"] = "string"
+		stringJSONSchema(out, d.Rules, unsupported)
+		if d.Required && d.Default == nil {
+			// valet rejects empty required strings
+			if n, ok := out["minLength"].(int); !ok || n < 1 {
+				out["minLength"] = 1
+			}
+		}
+	case "number", "integer":
+		out["type"] = d.Type
+		numberJSONSchema(out, d.Rules, unsupported)
+	case "boolean":
+		out["type"] = "boolean"
+		for _, r := range d.Rules {
+			switch r.Name {
+			case "true":
+				out["const"] = true
+			case "false":
+				out["const"] = false
+			default:
+				unsupported(r)
+			}
+		}
+	case "array":
+		out["type"] = "array"
+		if d.Element != nil {
+			out["items"] = x.field(*d.Element)
+		}
+		arrayJSONSchema(out, d.Rules, unsupported)
+	case "object":
+		strict := false
+		for _, r := range d.Rules {
+			if r.Name == "strict" {
+				strict = true
+			} else {
+				unsupported(r)
+			}
+		}
+		for k, v := range x.object(d.Fields, strict) {
+			out[k] = v
+		}
+	case "time":
+		out["type"] = "string"
+		for _, r := range d.Rules {
+			if r.Name != "format" {
+				unsupported(r)
+				continue
+			}
+			if format := timeJSONFormat(r.Param); format != "" {
+				out["format"] = format
+			} else {
+				unsupported(r)
+			}
+		}
+	case "enum":
+		for _, r := range d.Rules {
+			if r.Name == "enum" {
+				out["enum"] = anySlice(r.Param)
+			} else {
+				unsupported(r)
+			}
+		}
+	case "literal":
+		for _, r := range d.Rules {
+			if r.Name == "literal" {
+				out["const"] = r.Param
+			} else {
+				unsupported(r)
+			}
+		}
+	case "union":
+		variants := make([]any, len(d.Variants))
+		for i, v := range d.Variants {
+			variants[i] = x.field(v)
+		}
+		out["anyOf"] = variants
+		for _, r := range d.Rules {
+			if r.Name != "union" {
+				unsupported(r)
+			}
+		}
+	case "any":
+		for _, r := range d.Rules {
+			unsupported(r)
+		}
+	case "file":
+		if x.openAPI {
+			openAPIFileSchema(out, d.Rules, unsupported)
+			break
+		}
+		fallthrough
+	default: // file, custom
+		out[JSONSchemaExtType] = d.Type
+		for _, r := range d.Rules {
+			unsupported(r)
+		}
+	}
+
+	if d.Default != nil {
+		out["default"] = d.Default
+	}
+	if d.Nullable {
+		out = nullableJSONSchema(out)
+	}
+	if len(rules) > 0 {
+		out[JSONSchemaExtRules] = rules
+	}
+	if len(d.DB) > 0 {
+		out[JSONSchemaExtDB] = dbJSONSchema(d.DB)
+	}
+	return out
+}
+
+// stringFormats maps string rules to JSON Schema formats
+var stringFormats = map[string]string{
+	"email": "email",
+	"url":   "uri",
+	"uuid":  "uuid",
+	"ipv4":  "ipv4",
+	"ipv6":  "ipv6",
+}
+
+// stringPatterns maps string rules to the patterns valet checks them with
+var stringPatterns = map[string]*regexp.Regexp{
+	"alpha":        alphaRegex,
+	"alphaNumeric": alphaNumericRegex,
+	"alphaDash":    alphaDashRegex,
+	"hexColor":     hexColorRegex,
+	"ulid":         ulidRegex,
+	"mac":          macRegex,
+}
+
+// stringJSONSchema converts string rules
+func stringJSONSchema(out map[string]any, rules []RuleDescription, unsupported func(RuleDescription)) {
+	var patterns, notPatterns []string
+	for _, r := range rules {
+		if format, ok := stringFormats[r.Name]; ok {
+			out["format"] = format
+			continue
+		}
+		if re, ok := stringPatterns[r.Name]; ok {
+			patterns = append(patterns, re.String())
+			continue
+		}
+		switch r.Name {
+		case "min":
+			out["minLength"] = r.Param
+		case "max":
+			out["maxLength"] = r.Param
+		case "length":
+			out["minLength"] = r.Param
+			out["maxLength"] = r.Param
+		case "regex":
+			patterns = append(patterns, r.Param.(string))
+		case "notRegex":
+			notPatterns = append(notPatterns, r.Param.(string))
+		case "startsWith":
+			patterns = append(patterns, "^"+regexp.QuoteMeta(r.Param.(string)))
+		case "endsWith":
+			patterns = append(patterns, regexp.QuoteMeta(r.Param.(string))+"$")
+		case "contains":
+			patterns = append(patterns, regexp.QuoteMeta(r.Param.(string)))
+		case "includes":
+			for _, s := range r.Param.([]string) {
+				patterns = append(patterns, regexp.QuoteMeta(s))
+			}
+		case "doesntStartWith":
+			for _, s := range r.Param.([]string) {
+				notPatterns = append(notPatterns, "^"+regexp.QuoteMeta(s))
+			}
+		case "doesntEndWith":
+			for _, s := range r.Param.([]string) {
+				notPatterns = append(notPatterns, regexp.QuoteMeta(s)+"$")
+			}
+		case "digits":
+			patterns = append(patterns, "^[0-9]{"+strconv.Itoa(r.Param.(int))+"}$")
+		case "ascii":
+			patterns = append(patterns, "^[\\x00-\\x7F]*$")
+		case "in":
+			out["enum"] = anySlice(r.Param)
+		case "notIn":
+			out["not"] = map[string]any{"enum": anySlice(r.Param)}
+		case "ip":
+			out["anyOf"] = []any{map[string]any{"format": "ipv4"}, map[string]any{"format": "ipv6"}}
+		case "json":
+			out["contentMediaType"] = "application/json"
+		case "base64":
+			out["contentEncoding"] = "base64"
+		default:
+			unsupported(r)
+		}
+	}
+	addPatterns(out, patterns, notPatterns)
+}
+
+// addPatterns sets pattern, combining several with allOf
+func addPatterns(out map[string]any, patterns, notPatterns []string) {
+	var all []any
+	for i, p := range patterns {
+		if i == 0 {
+			out["pattern"] = p
+			continue
+		}
+		all = append(all, map[string]any{"pattern": p})
+	}
+	for _, p := range notPatterns {
+		all = append(all, map[string]any{"not": map[string]any{"pattern": p}})
+	}
+	if len(all) > 0 {
+		out["allOf"] = all
+	}
+}
+
+// numberJSONSchema converts number rules
+func numberJSONSchema(out map[string]any, rules []RuleDescription, unsupported func(RuleDescription)) {
+	for _, r := range rules {
+		switch r.Name {
+		case "min":
+			out["minimum"] = r.Param
+		case "max":
+			out["maximum"] = r.Param
+		case "positive":
+			out["exclusiveMinimum"] = 0
+		case "negative":
+			out["exclusiveMaximum"] = 0
+		case "multipleOf":
+			out["multipleOf"] = r.Param
+		case "integer":
+			out["type"] = "integer"
+		case "in":
+			out["enum"] = anySlice(r.Param)
+		case "notIn":
+			out["not"] = map[string]any{"enum": anySlice(r.Param)}
+		default:
+			unsupported(r)
+		}
+	}
+}
+
+// arrayJSONSchema converts array rules
+func arrayJSONSchema(out map[string]any, rules []RuleDescription, unsupported func(RuleDescription)) {
+	for _, r := range rules {
+		switch r.Name {
+		case "min":
+			out["minItems"] = r.Param
+		case "max":
+			out["maxItems"] = r.Param
+		case "length":
+			out["minItems"] = r.Param
+			out["maxItems"] = r.Param
+		case "unique":
+			out["uniqueItems"] = true
+		case "contains":
+			var all []any
+			for _, v := range anySlice(r.Param) {
+				all = append(all, map[string]any{"contains": map[string]any{"const": v}})
+			}
+			out["allOf"] = all
+		case "doesntContain":
+			out["not"] = map[string]any{"contains": map[string]any{"enum": anySlice(r.Param)}}
+		default:
+			unsupported(r)
+		}
+	}
+}
+
+// timeJSONFormat maps a Go time layout to a JSON Schema format
+func timeJSONFormat(layout any) string {
+	switch layout {
+	case time.RFC3339, time.RFC3339Nano:
+		return "date-time"
+	case time.DateOnly:
+		return "date"
+	case time.TimeOnly:
+		return "time"
+	}
+	return ""
+}
+
+// nullableJSONSchema allows null alongside the schema
+func nullableJSONSchema(out map[string]any) map[string]any {
+	if typ, ok := out["type"].(string); ok {
+		out["type"] = []string{typ, "null"}
+		if values, ok := out["enum"].([]any); ok {
+			out["enum"] = append(values, nil)
+		}
+		if value, ok := out["const"]; ok {
+			// const alone would reject null
+			delete(out, "const")
+			out["enum"] = []any{value, nil}
+		}
+		return out
+	}
+	if variants, ok := out["anyOf"].([]any); ok {
+		out["anyOf"] = append(variants, map[string]any{"type": "null"})
+		return out
+	}
+	if _, ok := out["enum"]; ok || out["const"] != nil {
+		return map[string]any{"anyOf": []any{out, map[string]any{"type": "null"}}}
+	}
+	return out // No type constraint: null is already allowed
+}
+
+// dbJSONSchema converts DB rules to the x-valet-db extension
+func dbJSONSchema(rules []DBRuleDescription) []map[string]any {
+	out := make([]map[string]any, len(rules))
+	for i, r := range rules {
+		rule := map[string]any{"rule": r.Rule}
+		switch {
+		case r.Func:
+			rule["func"] = true
+		case r.Query != nil:
+			rule["query"] = r.Query.SQL
+		default:
+			rule["table"] = r.Table
+			rule["column"] = r.Column
+		}
+		if len(r.Where) > 0 {
+			wheres := make([]string, len(r.Where))
+			for j, w := range r.Where {
+				wheres[j] = strings.TrimSpace(w.Column + " " + w.Operator)
+			}
+			rule["where"] = wheres
+		}
+		out[i] = rule
+	}
+	return out
+}
+
+// anySlice converts a typed slice parameter (e.g. []string) to []any
+func anySlice(v any) []any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return []any{v}
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out
+}
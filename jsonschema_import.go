@@ -0,0 +1,768 @@
+package valet
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportedSchema is the result of FromJSONSchema
+type ImportedSchema struct {
+	Schema Schema
+	// Unsupported lists the keywords that are not enforced, sorted by path
+	Unsupported []UnsupportedKeyword
+}
+
+// UnsupportedKeyword is a JSON Schema keyword FromJSONSchema did not import
+// or only approximated
+type UnsupportedKeyword struct {
+	Path    string // JSON pointer of the sub-schema, e.g. "#/properties/tags"
+	Keyword string
+	Reason  string
+}
+
+func (k UnsupportedKeyword) String() string {
+	return k.Path + "/" + k.Keyword + ": " + k.Reason
+}
+
+// Report lists the unsupported keywords, one per line
+func (s *ImportedSchema) Report() string {
+	lines := make([]string, len(s.Unsupported))
+	for i, k := range s.Unsupported {
+		lines[i] = k.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// jsonSchemaAnnotations are keywords that do not affect validation
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "$defs": true, "definitions": true,
+	"title": true, "description": true, "examples": true, "deprecated": true,
+	"readOnly": true, "writeOnly": true,
+}
+
+// FromJSONSchema builds a Schema from a JSON Schema (draft 2020-12) object
+// document. It imports type, properties, required, additionalProperties,
+// enum, const, anyOf/oneOf (as Union), allOf (merged), $ref to $defs,
+// format, default and string/number/array bounds. Keywords it cannot enforce
+// are listed in Unsupported rather than failing the import; invalid JSON,
+// non-object roots and unresolvable $refs are errors.
+func FromJSONSchema(data []byte) (*ImportedSchema, error) {
+	var root map[string]any
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("valet: invalid JSON Schema: %w", err)
+	}
+
+	im := &jsonSchemaImporter{root: root, resolving: make(map[string]bool), following: make(map[string]bool)}
+	n, err := im.resolve(root, "#")
+	if err != nil {
+		return nil, err
+	}
+	if typ, _ := n.m["type"].(string); typ != "object" && n.m["properties"] == nil {
+		return nil, fmt.Errorf("valet: JSON Schema root must be an object schema")
+	}
+	n.use("type")
+	if n.m["additionalProperties"] == false {
+		n.use("additionalProperties")
+		im.report(n.path, "additionalProperties", "unknown top-level keys are not rejected; wrap the schema in Object().Strict()")
+	}
+
+	schema, err := im.properties(n)
+	if err != nil {
+		return nil, err
+	}
+	if n.err != nil {
+		return nil, n.err
+	}
+	im.reportUnused(n)
+
+	sort.SliceStable(im.unsupported, func(i, j int) bool {
+		return im.unsupported[i].Path < im.unsupported[j].Path
+	})
+	return &ImportedSchema{Schema: schema, Unsupported: im.unsupported}, nil
+}
+
+// jsonSchemaImporter builds validators from a decoded JSON Schema
+type jsonSchemaImporter struct {
+	root        map[string]any
+	unsupported []UnsupportedKeyword
+	resolving   map[string]bool // $refs being built, to detect recursion
+	following   map[string]bool // $refs being resolved, to detect $ref cycles
+}
+
+// jsonSchemaNode is a sub-schema with the keywords consumed so far
+type jsonSchemaNode struct {
+	m    map[string]any
+	path string
+	used map[string]bool
+	err  error // First malformed keyword
+}
+
+// malformed reports a keyword whose value has the wrong JSON type
+func malformed(path, key, want string) error {
+	return fmt.Errorf("valet: JSON Schema %s/%s must be %s", path, key, want)
+}
+
+// fail records the first malformed keyword of the node
+func (n *jsonSchemaNode) fail(key, want string) {
+	if n.err == nil {
+		n.err = malformed(n.path, key, want)
+	}
+}
+
+// use marks keywords as imported
+func (n *jsonSchemaNode) use(keys ...string) {
+	for _, k := range keys {
+		n.used[k] = true
+	}
+}
+
+// get returns a keyword and marks it imported
+func (n *jsonSchemaNode) get(key string) (any, bool) {
+	v, ok := n.m[key]
+	n.used[key] = true
+	return v, ok
+}
+
+// number returns a numeric keyword
+func (n *jsonSchemaNode) number(key string) (float64, bool) {
+	v, ok := n.get(key)
+	f, isNum := v.(float64)
+	if ok && !isNum {
+		n.fail(key, "a number")
+	}
+	return f, ok && isNum
+}
+
+// list returns an array keyword
+func (n *jsonSchemaNode) list(key string) ([]any, bool) {
+	v, ok := n.get(key)
+	l, isList := v.([]any)
+	if ok && !isList {
+		n.fail(key, "an array")
+	}
+	return l, ok && isList
+}
+
+// str returns a string keyword
+func (n *jsonSchemaNode) str(key string) (string, bool) {
+	v, ok := n.get(key)
+	s, isString := v.(string)
+	if ok && !isString {
+		n.fail(key, "a string")
+	}
+	return s, ok && isString
+}
+
+func (im *jsonSchemaImporter) report(path, keyword, reason string) {
+	im.unsupported = append(im.unsupported, UnsupportedKeyword{Path: path, Keyword: keyword, Reason: reason})
+}
+
+// reportUnused reports the keywords of a node that were not imported
+func (im *jsonSchemaImporter) reportUnused(n *jsonSchemaNode) {
+	keys := make([]string, 0, len(n.m))
+	for k := range n.m {
+		if !n.used[k] && !jsonSchemaAnnotations[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		im.report(n.path, k, "keyword is not supported")
+	}
+}
+
+// resolve follows $ref and merges allOf into a single node
+func (im *jsonSchemaImporter) resolve(m map[string]any, path string) (*jsonSchemaNode, error) {
+	n := &jsonSchemaNode{m: m, path: path, used: make(map[string]bool)}
+
+	if ref, ok := m["$ref"].(string); ok {
+		if im.following[ref] {
+			return nil, fmt.Errorf("valet: recursive JSON Schema $ref %q is not supported", ref)
+		}
+		im.following[ref] = true
+		defer delete(im.following, ref)
+		target, err := im.lookupRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		merged := make(map[string]any, len(target)+len(m))
+		for k, v := range target {
+			merged[k] = v
+		}
+		for k, v := range m { // Sibling keywords apply alongside the $ref
+			if k != "$ref" {
+				merged[k] = v
+			}
+		}
+		return im.resolve(merged, ref)
+	}
+
+	rawAll, ok := m["allOf"]
+	if !ok {
+		return n, nil
+	}
+	all, ok := rawAll.([]any)
+	if !ok {
+		return nil, malformed(path, "allOf", "an array")
+	}
+	merged := make(map[string]any, len(m))
+	for k, v := range m {
+		if k != "allOf" {
+			merged[k] = v
+		}
+	}
+	for i, item := range all {
+		sub, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		resolved, err := im.resolve(sub, path+"/allOf/"+strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		if err := im.mergeAllOf(merged, resolved.m, resolved.path); err != nil {
+			return nil, err
+		}
+	}
+	return im.resolve(merged, path)
+}
+
+// mergeAllOf merges an allOf member into the combined schema: properties and
+// required are combined, other keywords must not conflict
+func (im *jsonSchemaImporter) mergeAllOf(into, sub map[string]any, path string) error {
+	for k, v := range sub {
+		existing, ok := into[k]
+		switch {
+		case !ok:
+			into[k] = v
+		case k == "properties":
+			existingProps, ok1 := existing.(map[string]any)
+			subProps, ok2 := v.(map[string]any)
+			if !ok1 || !ok2 {
+				return malformed(path, "properties", "an object")
+			}
+			props := make(map[string]any)
+			for name, p := range existingProps {
+				props[name] = p
+			}
+			for name, p := range subProps {
+				if _, dup := props[name]; dup {
+					im.report(path+"/properties", name, "property is defined by several allOf members; the first definition is used")
+					continue
+				}
+				props[name] = p
+			}
+			into[k] = props
+		case k == "required":
+			existingList, ok1 := existing.([]any)
+			subList, ok2 := v.([]any)
+			if !ok1 || !ok2 {
+				return malformed(path, "required", "an array")
+			}
+			into[k] = append(append([]any{}, existingList...), subList...)
+		case !reflect.DeepEqual(existing, v):
+			im.report(path, k, "conflicts with another allOf member; the first value is used")
+		}
+	}
+	return nil
+}
+
+// lookupRef resolves a local JSON pointer reference (e.g. "#/$defs/Address")
+func (im *jsonSchemaImporter) lookupRef(ref string) (map[string]any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("valet: JSON Schema $ref %q is not a local reference", ref)
+	}
+	var current any = im.root
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+		obj, ok := current.(map[string]any)
+		if !ok {
+			current = nil
+			break
+		}
+		current = obj[part]
+	}
+	target, ok := current.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("valet: JSON Schema $ref %q not found", ref)
+	}
+	return target, nil
+}
+
+// properties builds a Schema from an object node's properties
+func (im *jsonSchemaImporter) properties(n *jsonSchemaNode) (Schema, error) {
+	required := make(map[string]bool)
+	list, _ := n.list("required")
+	for _, name := range list {
+		s, ok := name.(string)
+		if !ok {
+			n.fail("required", "an array of strings")
+			continue
+		}
+		required[s] = true
+	}
+
+	props, hasProps := n.get("properties")
+	propMap, ok := props.(map[string]any)
+	if hasProps && !ok {
+		n.fail("properties", "an object")
+	}
+	if n.err != nil {
+		return nil, n.err
+	}
+
+	schema := Schema{}
+	for name, p := range propMap {
+		sub, ok := p.(map[string]any)
+		if !ok {
+			// true / false sub-schemas
+			im.report(n.path+"/properties", name, "boolean sub-schemas are not supported; any value is accepted")
+			schema[name] = Any()
+			continue
+		}
+		v, err := im.build(sub, n.path+"/properties/"+name, required[name])
+		if err != nil {
+			return nil, err
+		}
+		schema[name] = v
+	}
+	for name := range required {
+		if _, ok := schema[name]; !ok {
+			schema[name] = Any().Required()
+		}
+	}
+	return schema, nil
+}
+
+// build converts a sub-schema to a validator
+func (im *jsonSchemaImporter) build(m map[string]any, path string, required bool) (Validator, error) {
+	ref, _ := m["$ref"].(string)
+	if ref != "" {
+		if im.resolving[ref] {
+			return nil, fmt.Errorf("valet: recursive JSON Schema $ref %q is not supported", ref)
+		}
+		im.resolving[ref] = true
+		defer delete(im.resolving, ref)
+	}
+	n, err := im.resolve(m, path)
+	if err != nil {
+		return nil, err
+	}
+	v, err := im.buildNode(n, required)
+	if err != nil {
+		return nil, err
+	}
+	if n.err != nil {
+		return nil, n.err
+	}
+	im.reportUnused(n)
+	return v, nil
+}
+
+// buildNode converts a resolved node to a validator
+func (im *jsonSchemaImporter) buildNode(n *jsonSchemaNode, required bool) (Validator, error) {
+	typ, nullable := im.nodeType(n)
+
+	if c, ok := n.get("const"); ok {
+		if !isScalar(c) {
+			im.report(n.path, "const", "non-scalar values are not supported")
+			return Any(), nil
+		}
+		v := Literal[any](c)
+		if required {
+			v.Required()
+		}
+		if nullable {
+			v.Nullable()
+		}
+		return v, nil
+	}
+
+	for _, key := range []string{"anyOf", "oneOf"} {
+		list, ok := n.list(key)
+		if !ok {
+			continue
+		}
+		if key == "oneOf" {
+			im.report(n.path, "oneOf", "validated as anyOf: values matching several members are accepted")
+		}
+		var variants []Validator
+		for i, item := range list {
+			sub, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if t, _ := sub["type"].(string); t == "null" && len(sub) == 1 {
+				nullable = true
+				continue
+			}
+			v, err := im.build(sub, n.path+"/"+key+"/"+strconv.Itoa(i), false)
+			if err != nil {
+				return nil, err
+			}
+			variants = append(variants, v)
+		}
+		u := Union(variants...)
+		if required {
+			u.Required()
+		}
+		if nullable {
+			u.Nullable()
+		}
+		return u, nil
+	}
+
+	switch typ {
+	case "string":
+		return im.buildString(n, required, nullable)
+	case "number", "integer":
+		return im.buildNumber(n, typ == "integer", required, nullable)
+	case "boolean":
+		v := Bool()
+		if required {
+			v.Required()
+		}
+		if nullable {
+			v.Nullable()
+		}
+		if d, ok := n.get("default"); ok {
+			if b, ok := d.(bool); ok {
+				v.Default(b)
+			}
+		}
+		return v, nil
+	case "array":
+		return im.buildArray(n, required, nullable)
+	case "object":
+		return im.buildObject(n, required, nullable)
+	}
+
+	if list, ok := n.list("enum"); ok {
+		for _, e := range list {
+			if e == nil {
+				nullable = true
+			} else if !isScalar(e) {
+				im.report(n.path, "enum", "non-scalar values are not supported")
+				return Any(), nil
+			}
+		}
+		v := Enum[any](nonNil(list)...)
+		if required {
+			v.Required()
+		}
+		if nullable {
+			v.Nullable()
+		}
+		return v, nil
+	}
+
+	v := Any()
+	if required {
+		v.Required()
+	}
+	return v, nil
+}
+
+// nodeType returns the node's single non-null type and whether null is allowed
+func (im *jsonSchemaImporter) nodeType(n *jsonSchemaNode) (string, bool) {
+	raw, ok := n.get("type")
+	if !ok {
+		// Infer the type from type-specific keywords
+		switch {
+		case n.m["properties"] != nil:
+			return "object", false
+		case n.m["items"] != nil:
+			return "array", false
+		}
+		return "", false
+	}
+	if s, ok := raw.(string); ok {
+		return s, false
+	}
+	list, ok := raw.([]any)
+	if !ok {
+		n.fail("type", "a string or an array of strings")
+		return "", false
+	}
+	var types []string
+	nullable := false
+	for _, t := range list {
+		s, ok := t.(string)
+		switch {
+		case !ok:
+			n.fail("type", "a string or an array of strings")
+		case s == "null":
+			nullable = true
+		default:
+			types = append(types, s)
+		}
+	}
+	if len(types) == 1 {
+		return types[0], nullable
+	}
+	if len(types) > 1 {
+		im.report(n.path, "type", "multiple types are not supported; any value is accepted")
+	}
+	return "", nullable
+}
+
+// jsonSchemaFormats maps formats to string validator rules
+var jsonSchemaFormats = map[string]func(*StringValidator){
+	"email": func(v *StringValidator) { v.Email() },
+	"uri":   func(v *StringValidator) { v.URL() },
+	"url":   func(v *StringValidator) { v.URL() },
+	"uuid":  func(v *StringValidator) { v.UUID() },
+	"ipv4":  func(v *StringValidator) { v.IPv4() },
+	"ipv6":  func(v *StringValidator) { v.IPv6() },
+}
+
+// jsonSchemaTimeFormats maps formats to time layouts
+var jsonSchemaTimeFormats = map[string]string{
+	"date-time": time.RFC3339,
+	"date":      time.DateOnly,
+	"time":      time.TimeOnly,
+}
+
+func (im *jsonSchemaImporter) buildString(n *jsonSchemaNode, required, nullable bool) (Validator, error) {
+	format, _ := n.m["format"].(string)
+	if layout, ok := jsonSchemaTimeFormats[format]; ok {
+		n.use("format")
+		v := Time().Format(layout)
+		if required {
+			v.Required()
+		}
+		if nullable {
+			v.Nullable()
+		}
+		return v, nil
+	}
+
+	v := String()
+	if required {
+		v.Required()
+	}
+	if nullable {
+		v.Nullable()
+	}
+	if format != "" {
+		if apply, ok := jsonSchemaFormats[format]; ok {
+			n.use("format")
+			apply(v)
+		}
+	}
+	if min, ok := n.number("minLength"); ok {
+		v.Min(int(min))
+	}
+	if max, ok := n.number("maxLength"); ok {
+		v.Max(int(max))
+	}
+	if pattern, ok := n.str("pattern"); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			im.report(n.path, "pattern", fmt.Sprintf("not supported by Go regexp (%v); the pattern is not checked", err))
+		} else {
+			v.Regex(pattern)
+		}
+	}
+	if values, ok := n.list("enum"); ok {
+		var in []string
+		for _, e := range values {
+			if s, ok := e.(string); ok {
+				in = append(in, s)
+			} else if e == nil {
+				v.Nullable()
+			}
+		}
+		v.In(in...)
+	}
+	if d, ok := n.get("default"); ok {
+		if s, ok := d.(string); ok {
+			v.Default(s)
+		}
+	}
+	if enc, _ := n.m["contentEncoding"].(string); enc == "base64" {
+		n.use("contentEncoding")
+		v.Base64()
+	}
+	if media, _ := n.m["contentMediaType"].(string); media == "application/json" {
+		n.use("contentMediaType")
+		v.JSON()
+	}
+
+	// valet rejects "" for required strings and skips the rules of empty
+	// optional strings, which JSON Schema does not
+	keyword := emptyStringKeyword(n)
+	switch {
+	case required && keyword == "":
+		im.report(n.path, "required", `"" is rejected because valet treats empty required strings as missing`)
+	case !required && keyword != "":
+		im.report(n.path, keyword, `"" is accepted because valet skips the rules of empty optional strings`)
+	}
+	return v, nil
+}
+
+// emptyStringKeyword returns the keyword of a string schema that rejects "",
+// or "" when the empty string is valid
+func emptyStringKeyword(n *jsonSchemaNode) string {
+	if min, ok := n.m["minLength"].(float64); ok && min >= 1 {
+		return "minLength"
+	}
+	if format, _ := n.m["format"].(string); jsonSchemaFormats[format] != nil {
+		return "format" // Imported formats are asserted, and none accepts ""
+	}
+	if values, ok := n.m["enum"].([]any); ok && !containsEmptyString(values) {
+		return "enum"
+	}
+	if pattern, ok := n.m["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString("") {
+			return "pattern"
+		}
+	}
+	return ""
+}
+
+// containsEmptyString reports whether an enum allows ""
+func containsEmptyString(values []any) bool {
+	for _, e := range values {
+		if s, ok := e.(string); ok && s == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (im *jsonSchemaImporter) buildNumber(n *jsonSchemaNode, integer, required, nullable bool) (Validator, error) {
+	v := Float()
+	if integer {
+		v.Integer()
+	}
+	if required {
+		v.Required()
+	}
+	if nullable {
+		v.Nullable()
+	}
+	if min, ok := n.number("minimum"); ok {
+		v.Min(min)
+	}
+	if max, ok := n.number("maximum"); ok {
+		v.Max(max)
+	}
+	if m, ok := n.number("multipleOf"); ok {
+		v.MultipleOf(m)
+	}
+
+	exMin, hasExMin := n.number("exclusiveMinimum")
+	exMax, hasExMax := n.number("exclusiveMaximum")
+	switch {
+	case hasExMin && exMin == 0 && !hasExMax:
+		v.Positive()
+	case hasExMax && exMax == 0 && !hasExMin:
+		v.Negative()
+	case hasExMin || hasExMax:
+		v.Custom(func(value float64, _ Lookup) error {
+			if hasExMin && value <= exMin {
+				return fmt.Errorf("must be greater than %v", exMin)
+			}
+			if hasExMax && value >= exMax {
+				return fmt.Errorf("must be less than %v", exMax)
+			}
+			return nil
+		})
+	}
+
+	if values, ok := n.list("enum"); ok {
+		var in []float64
+		for _, e := range values {
+			if f, ok := e.(float64); ok {
+				in = append(in, f)
+			} else if e == nil {
+				v.Nullable()
+			}
+		}
+		v.In(in...)
+	}
+	if d, ok := n.get("default"); ok {
+		if f, ok := d.(float64); ok {
+			v.Default(f)
+		}
+	}
+	return v, nil
+}
+
+func (im *jsonSchemaImporter) buildArray(n *jsonSchemaNode, required, nullable bool) (Validator, error) {
+	v := Array()
+	if required {
+		v.Required()
+	}
+	if nullable {
+		v.Nullable()
+	}
+	if items, ok := n.get("items"); ok {
+		if sub, ok := items.(map[string]any); ok {
+			element, err := im.build(sub, n.path+"/items", false)
+			if err != nil {
+				return nil, err
+			}
+			v.Of(element)
+		}
+	}
+	if min, ok := n.number("minItems"); ok {
+		v.Min(int(min))
+	}
+	if max, ok := n.number("maxItems"); ok {
+		v.Max(int(max))
+	}
+	if unique, _ := n.m["uniqueItems"].(bool); unique {
+		n.use("uniqueItems")
+		v.Unique()
+	}
+	return v, nil
+}
+
+func (im *jsonSchemaImporter) buildObject(n *jsonSchemaNode, required, nullable bool) (Validator, error) {
+	shape, err := im.properties(n)
+	if err != nil {
+		return nil, err
+	}
+	v := Object().Shape(shape)
+	if required {
+		v.Required()
+	}
+	if nullable {
+		v.Nullable()
+	}
+	switch additional := n.m["additionalProperties"].(type) {
+	case bool:
+		n.use("additionalProperties")
+		if !additional {
+			v.Strict()
+		}
+	}
+	return v, nil
+}
+
+// isScalar reports whether a decoded JSON value is comparable
+func isScalar(v any) bool {
+	switch v.(type) {
+	case nil, string, float64, bool:
+		return true
+	}
+	return false
+}
+
+// nonNil drops null from enum values
+func nonNil(values []any) []any {
+	out := make([]any, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			out = append(out, v)
+		}
+	}
+	return out
+}
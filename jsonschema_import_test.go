@@ -0,0 +1,229 @@
+package valet
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func mustImport(t *testing.T, doc string) *ImportedSchema {
+	t.Helper()
+	imported, err := FromJSONSchema([]byte(doc))
+	if err != nil {
+		t.Fatalf("FromJSONSchema error: %v", err)
+	}
+	return imported
+}
+
+func TestFromJSONSchema_Basic(t *testing.T) {
+	imported := mustImport(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["email", "age"],
+		"properties": {
+			"email": {"type": "string", "format": "email", "maxLength": 100},
+			"age":   {"type": "integer", "minimum": 18},
+			"role":  {"type": "string", "enum": ["admin", "user"], "default": "user"},
+			"score": {"type": ["number", "null"], "exclusiveMinimum": 0, "multipleOf": 0.5},
+			"born":  {"type": "string", "format": "date"}
+		}
+	}`)
+	// An optional enum skips its rules for ""
+	if want := `#/properties/role/enum: "" is accepted because valet skips the rules of empty optional strings`; imported.Report() != want {
+		t.Errorf("Unexpected unsupported keywords:\n%s", imported.Report())
+	}
+	schema := imported.Schema
+
+	valid := DataObject{"email": "a@example.com", "age": float64(30), "score": nil, "born": "1990-01-02"}
+	if err := Validate(valid, schema); err != nil {
+		t.Errorf("Expected valid, got %v", err.Errors)
+	}
+
+	invalid := DataObject{"email": "nope", "age": 17.5, "role": "root", "score": float64(-1), "born": "02/01/1990"}
+	err := Validate(invalid, schema)
+	if err == nil {
+		t.Fatal("Expected errors")
+	}
+	for _, field := range []string{"email", "age", "role", "score", "born"} {
+		if len(err.Errors[field]) == 0 {
+			t.Errorf("Expected error for %s, got %v", field, err.Errors)
+		}
+	}
+	if len(err.Errors["age"]) != 2 {
+		t.Errorf("Expected integer and min errors for age, got %v", err.Errors["age"])
+	}
+}
+
+func TestFromJSONSchema_EmptyStrings(t *testing.T) {
+	imported := mustImport(t, `{
+		"type": "object",
+		"required": ["name", "code"],
+		"properties": {
+			"name": {"type": "string"},
+			"code": {"type": "string", "minLength": 1},
+			"nick": {"type": "string", "minLength": 2},
+			"slug": {"type": "string", "pattern": "^[a-z]+$"},
+			"note": {"type": "string", "maxLength": 10}
+		}
+	}`)
+	want := []string{
+		`#/properties/name/required: "" is rejected because valet treats empty required strings as missing`,
+		`#/properties/nick/minLength: "" is accepted because valet skips the rules of empty optional strings`,
+		`#/properties/slug/pattern: "" is accepted because valet skips the rules of empty optional strings`,
+	}
+	got := strings.Split(imported.Report(), "\n")
+	sort.Strings(got)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected report:\n%s", imported.Report())
+	}
+}
+
+func TestFromJSONSchema_Composition(t *testing.T) {
+	imported := mustImport(t, `{
+		"type": "object",
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"required": ["city"],
+				"properties": {"city": {"type": "string", "minLength": 2}},
+				"additionalProperties": false
+			},
+			"Named": {"properties": {"name": {"type": "string"}}, "required": ["name"]}
+		},
+		"allOf": [{"$ref": "#/$defs/Named"}],
+		"properties": {
+			"address": {"$ref": "#/$defs/Address"},
+			"tags":    {"type": "array", "items": {"type": "string"}, "minItems": 1, "uniqueItems": true},
+			"id":      {"anyOf": [{"type": "string", "format": "uuid"}, {"type": "integer"}]},
+			"kind":    {"const": "post"},
+			"status":  {"enum": ["draft", 1, null]}
+		}
+	}`)
+	schema := imported.Schema
+
+	d := DescribeSchema(schema)
+	if !d["name"].Required || d["address"].Type != "object" || d["id"].Type != "union" || d["kind"].Type != "literal" || d["status"].Type != "enum" {
+		t.Errorf("Unexpected schema: %+v", d)
+	}
+
+	valid := DataObject{
+		"name":    "Ann",
+		"address": map[string]any{"city": "Berlin"},
+		"tags":    []any{"a", "b"},
+		"id":      float64(7),
+		"kind":    "post",
+		"status":  float64(1),
+	}
+	if err := Validate(valid, schema); err != nil {
+		t.Errorf("Expected valid, got %v", err.Errors)
+	}
+
+	invalid := DataObject{
+		"address": map[string]any{"city": "B", "zip": "1"},
+		"tags":    []any{"a", "a"},
+		"id":      true,
+		"kind":    "page",
+		"status":  "gone",
+	}
+	err := Validate(invalid, schema)
+	if err == nil {
+		t.Fatal("Expected errors")
+	}
+	for _, path := range []string{"name", "address.city", "address", "tags.1", "id", "kind", "status"} {
+		if len(err.Errors[path]) == 0 {
+			t.Errorf("Expected error for %s, got %v", path, err.Errors)
+		}
+	}
+}
+
+func TestFromJSONSchema_Unsupported(t *testing.T) {
+	imported := mustImport(t, `{
+		"type": "object",
+		"properties": {
+			"a": {"type": "string", "not": {"const": "x"}},
+			"b": {"oneOf": [{"type": "string"}, {"type": "number"}]},
+			"c": {"type": "object", "patternProperties": {"^x": {}}}
+		}
+	}`)
+	got := map[string]bool{}
+	for _, k := range imported.Unsupported {
+		got[k.Path+" "+k.Keyword] = true
+	}
+	for _, want := range []string{"#/properties/a not", "#/properties/b oneOf", "#/properties/c patternProperties"} {
+		if !got[want] {
+			t.Errorf("Missing %q in report:\n%s", want, imported.Report())
+		}
+	}
+	if !strings.Contains(imported.Report(), "#/properties/a/not: keyword is not supported") {
+		t.Errorf("Unexpected report:\n%s", imported.Report())
+	}
+}
+
+func TestFromJSONSchema_Errors(t *testing.T) {
+	docs := map[string]string{
+		"invalid JSON": `{`,
+		"non-object":   `{"type": "string"}`,
+		"missing ref":  `{"type": "object", "properties": {"a": {"$ref": "#/$defs/Nope"}}}`,
+		"remote ref":   `{"type": "object", "properties": {"a": {"$ref": "https://example.com/a.json"}}}`,
+		"recursive": `{"type": "object", "$defs": {"Node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/Node"}}}},
+			"properties": {"root": {"$ref": "#/$defs/Node"}}}`,
+	}
+	for name, doc := range docs {
+		if _, err := FromJSONSchema([]byte(doc)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	malformed := map[string]string{
+		"required string":    `{"type": "object", "required": "name", "properties": {"name": {"type": "string"}}}`,
+		"type number":        `{"type": "object", "properties": {"a": {"type": 5}}}`,
+		"type list":          `{"type": "object", "properties": {"a": {"type": ["string", 5]}}}`,
+		"pattern number":     `{"type": "object", "properties": {"a": {"type": "string", "pattern": 5}}}`,
+		"enum string":        `{"type": "object", "properties": {"a": {"enum": "x"}}}`,
+		"anyOf object":       `{"type": "object", "properties": {"a": {"anyOf": {}}}}`,
+		"allOf object":       `{"type": "object", "properties": {"a": {"allOf": {}}}}`,
+		"minLength string":   `{"type": "object", "properties": {"a": {"type": "string", "minLength": "3"}}}`,
+		"properties list":    `{"type": "object", "properties": {"a": {"type": "object", "properties": []}}}`,
+		"allOf required":     `{"type": "object", "required": ["a"], "allOf": [{"required": "b"}], "properties": {"a": {}}}`,
+		"allOf properties":   `{"type": "object", "properties": {"a": {}}, "allOf": [{"properties": []}]}`,
+		"nested enum string": `{"type": "object", "properties": {"a": {"type": "array", "items": {"type": "number", "enum": 1}}}}`,
+	}
+	for name, doc := range malformed {
+		_, err := FromJSONSchema([]byte(doc))
+		if err == nil || !strings.HasPrefix(err.Error(), "valet: JSON Schema #") {
+			t.Errorf("%s: expected error naming the path, got %v", name, err)
+		}
+	}
+	_, err := FromJSONSchema([]byte(malformed["type number"]))
+	if err == nil || err.Error() != "valet: JSON Schema #/properties/a/type must be a string or an array of strings" {
+		t.Errorf("Unexpected message: %v", err)
+	}
+}
+
+func TestFromJSONSchema_UnsupportedPattern(t *testing.T) {
+	imported := mustImport(t, `{"type": "object", "properties": {"a": {"type": "string", "pattern": "^(?=a).*$"}}}`)
+	if len(imported.Unsupported) != 1 || imported.Unsupported[0].Path != "#/properties/a" || imported.Unsupported[0].Keyword != "pattern" {
+		t.Errorf("Expected pattern in report, got:\n%s", imported.Report())
+	}
+}
+
+func TestFromJSONSchema_RoundTrip(t *testing.T) {
+	original := Schema{
+		"email": String().Required().Email().Max(100),
+		"tags":  Array().Min(1).Of(String().Min(2)),
+		"age":   Float().Min(0).Max(130),
+	}
+	b, err := json.Marshal(ToJSONSchema(original))
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	imported := mustImport(t, string(b))
+
+	data := DataObject{"email": "bad", "tags": []any{"x"}, "age": float64(200)}
+	want := Validate(data, original)
+	got := Validate(data, imported.Schema)
+	if want == nil || got == nil || len(want.Errors) != len(got.Errors) {
+		t.Errorf("Errors differ: original %v, imported %v", want, got)
+	}
+}
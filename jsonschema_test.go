@@ -0,0 +1,150 @@
+package valet
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// property returns a property schema from an exported object schema
+func property(t *testing.T, schema map[string]any, name string) map[string]any {
+	t.Helper()
+	props, _ := schema["properties"].(map[string]any)
+	p, ok := props[name].(map[string]any)
+	if !ok {
+		t.Fatalf("No property %s in %v", name, schema)
+	}
+	return p
+}
+
+func TestToJSONSchema_Object(t *testing.T) {
+	out := ToJSONSchema(Schema{
+		"name":  String().Required().Min(2).Max(50),
+		"email": String().Required().Email(),
+		"age":   Optional(Int().Min(18).MultipleOf(2)),
+	})
+
+	if out["$schema"] != JSONSchemaDraft || out["type"] != "object" {
+		t.Errorf("Unexpected root: %v", out)
+	}
+	if !reflect.DeepEqual(out["required"], []string{"email", "name"}) {
+		t.Errorf("required = %v", out["required"])
+	}
+	name := property(t, out, "name")
+	if name["type"] != "string" || name["minLength"] != 2 || name["maxLength"] != 50 {
+		t.Errorf("name = %v", name)
+	}
+	// valet rejects "" for required strings
+	if email := property(t, out, "email"); email["format"] != "email" || email["minLength"] != 1 {
+		t.Errorf("email = %v", email)
+	}
+	age := property(t, out, "age")
+	if age["type"] != "integer" || age["minimum"] != int64(18) || age["multipleOf"] != int64(2) {
+		t.Errorf("age = %v", age)
+	}
+
+	if _, err := json.Marshal(out); err != nil {
+		t.Errorf("Marshal error: %v", err)
+	}
+}
+
+func TestToJSONSchema_StringRules(t *testing.T) {
+	out := ToJSONSchema(Schema{
+		"id":   String().UUID(),
+		"code": String().Regex(`^[A-Z]+$`).StartsWith("AB"),
+		"role": String().Nullable().In("admin", "user"),
+		"pw":   String().SameAs("password"),
+	})
+
+	if id := property(t, out, "id"); id["format"] != "uuid" {
+		t.Errorf("id = %v", id)
+	}
+	code := property(t, out, "code")
+	if code["pattern"] != "^AB" || len(code["allOf"].([]any)) != 1 {
+		t.Errorf("code = %v", code)
+	}
+	role := property(t, out, "role")
+	if !reflect.DeepEqual(role["type"], []string{"string", "null"}) || !reflect.DeepEqual(role["enum"], []any{"admin", "user", nil}) {
+		t.Errorf("role = %v", role)
+	}
+	pw := property(t, out, "pw")
+	rules, _ := pw[JSONSchemaExtRules].([]map[string]any)
+	if len(rules) != 1 || rules[0]["rule"] != "sameAs" || rules[0]["param"] != "password" {
+		t.Errorf("pw = %v", pw)
+	}
+}
+
+func TestToJSONSchema_NullableConst(t *testing.T) {
+	out := ToJSONSchema(Schema{
+		"terms": Bool().True().Nullable(),
+		"kind":  Literal("post").Nullable(),
+	})
+
+	terms := property(t, out, "terms")
+	if _, ok := terms["const"]; ok || !reflect.DeepEqual(terms["enum"], []any{true, nil}) {
+		t.Errorf("terms = %v", terms)
+	}
+	kind := property(t, out, "kind")
+	if variants, _ := kind["anyOf"].([]any); len(variants) != 2 {
+		t.Errorf("kind = %v", kind)
+	}
+}
+
+func TestToJSONSchema_Nested(t *testing.T) {
+	out := ToJSONSchema(Schema{
+		"address": Object().Required().Strict().Shape(Schema{
+			"city": String().Required(),
+		}),
+		"tags":   Array().Min(1).Max(5).Unique().Of(String()),
+		"status": Enum("draft", "published"),
+		"kind":   Literal("post"),
+		"ref":    Union(String(), Float()),
+		"flag":   Bool().Default(false),
+	})
+
+	address := property(t, out, "address")
+	if address["type"] != "object" || address["additionalProperties"] != false || !reflect.DeepEqual(address["required"], []string{"city"}) {
+		t.Errorf("address = %v", address)
+	}
+	tags := property(t, out, "tags")
+	items, _ := tags["items"].(map[string]any)
+	if tags["minItems"] != 1 || tags["maxItems"] != 5 || tags["uniqueItems"] != true || items["type"] != "string" {
+		t.Errorf("tags = %v", tags)
+	}
+	if status := property(t, out, "status"); !reflect.DeepEqual(status["enum"], []any{"draft", "published"}) {
+		t.Errorf("status = %v", status)
+	}
+	if kind := property(t, out, "kind"); kind["const"] != "post" {
+		t.Errorf("kind = %v", kind)
+	}
+	if ref := property(t, out, "ref"); len(ref["anyOf"].([]any)) != 2 {
+		t.Errorf("ref = %v", ref)
+	}
+	if flag := property(t, out, "flag"); flag["default"] != false {
+		t.Errorf("flag = %v", flag)
+	}
+}
+
+func TestToJSONSchema_Extensions(t *testing.T) {
+	out := ToJSONSchema(Schema{
+		"user_id": Float().Exists("users", "id", WhereEq("active", true)),
+		"avatar":  File().Image(),
+		"note": String().Custom(func(string, Lookup) error {
+			return nil
+		}),
+	})
+
+	user := property(t, out, "user_id")
+	db, _ := user[JSONSchemaExtDB].([]map[string]any)
+	if len(db) != 1 || db[0]["rule"] != "exists" || db[0]["table"] != "users" || db[0]["column"] != "id" {
+		t.Errorf("user_id = %v", user)
+	}
+	avatar := property(t, out, "avatar")
+	if avatar[JSONSchemaExtType] != "file" || avatar[JSONSchemaExtRules] == nil {
+		t.Errorf("avatar = %v", avatar)
+	}
+	note := property(t, out, "note")
+	if rules, _ := note[JSONSchemaExtRules].([]map[string]any); len(rules) != 1 || rules[0]["rule"] != "custom" {
+		t.Errorf("note = %v", note)
+	}
+}
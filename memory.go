@@ -0,0 +1,432 @@
+package valet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordedQuery is a CheckExists or LoadRows call received by a MemoryChecker
+type RecordedQuery struct {
+	Table     string
+	Column    string
+	Values    []any
+	Wheres    []WhereClause
+	BatchSize int // Number of values in the call
+	Unique    bool
+	Load      bool // Received through LoadRows
+}
+
+// MemoryChecker is an in-memory DBChecker for tests and local development.
+// It evaluates where clauses with the same operators and SQL semantics as the
+// SQL adapters (NULL never matches comparisons, empty IN matches nothing) and
+// records every query it receives.
+type MemoryChecker struct {
+	mu      sync.Mutex
+	tables  map[string][]map[string]any
+	queries []RecordedQuery
+	latency time.Duration
+	errs    map[string]error
+}
+
+// NewMemoryChecker creates a checker with fixture rows per table
+func NewMemoryChecker(fixtures map[string][]map[string]any) *MemoryChecker {
+	m := &MemoryChecker{
+		tables: make(map[string][]map[string]any),
+		errs:   make(map[string]error),
+	}
+	for table, rows := range fixtures {
+		m.Insert(table, rows...)
+	}
+	return m
+}
+
+// NewMemoryCheckerFromJSON creates a checker from JSON fixtures shaped like
+// {"users": [{"id": 1, "email": "a@example.com"}]}
+func NewMemoryCheckerFromJSON(data []byte) (*MemoryChecker, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var fixtures map[string][]map[string]any
+	if err := dec.Decode(&fixtures); err != nil {
+		return nil, err
+	}
+	for _, rows := range fixtures {
+		for _, row := range rows {
+			for col, v := range row {
+				row[col] = fromJSONNumber(v)
+			}
+		}
+	}
+	return NewMemoryChecker(fixtures), nil
+}
+
+// fromJSONNumber converts json.Number to int64 or float64
+func fromJSONNumber(v any) any {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
+
+// Insert adds rows to a table
+func (m *MemoryChecker) Insert(table string, rows ...map[string]any) *MemoryChecker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tables[table] = append(m.tables[table], rows...)
+	return m
+}
+
+// Truncate removes all rows of a table
+func (m *MemoryChecker) Truncate(table string) *MemoryChecker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tables, table)
+	return m
+}
+
+// WithLatency delays every query, honoring context cancellation
+func (m *MemoryChecker) WithLatency(d time.Duration) *MemoryChecker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latency = d
+	return m
+}
+
+// FailWith makes queries against table return err; an empty table fails
+// every query and a nil err clears the failure
+func (m *MemoryChecker) FailWith(table string, err error) *MemoryChecker {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		delete(m.errs, table)
+	} else {
+		m.errs[table] = err
+	}
+	return m
+}
+
+// Queries returns the queries received so far
+func (m *MemoryChecker) Queries() []RecordedQuery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]RecordedQuery(nil), m.queries...)
+}
+
+// QueryCount returns the number of queries received so far
+func (m *MemoryChecker) QueryCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.queries)
+}
+
+// ResetQueries clears the recorded queries
+func (m *MemoryChecker) ResetQueries() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queries = nil
+}
+
+// CheckExists implements DBChecker
+func (m *MemoryChecker) CheckExists(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	rows, err := m.lookup(ctx, table, column, values, wheres, false)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[any]bool, len(rows))
+	for key := range rows {
+		result[key] = true
+	}
+	return result, nil
+}
+
+// LoadRows implements DBLoader, returning copies of the matched fixture rows
+func (m *MemoryChecker) LoadRows(ctx context.Context, table string, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error) {
+	return m.lookup(ctx, table, column, values, wheres, true)
+}
+
+func (m *MemoryChecker) lookup(ctx context.Context, table string, column string, values []any, wheres []WhereClause, load bool) (map[any]map[string]any, error) {
+	m.mu.Lock()
+	m.queries = append(m.queries, RecordedQuery{
+		Table:     table,
+		Column:    column,
+		Values:    append([]any(nil), values...),
+		Wheres:    wheres,
+		BatchSize: len(values),
+		Unique:    IsUniqueCheck(ctx),
+		Load:      load,
+	})
+	latency := m.latency
+	err := m.errs[table]
+	if err == nil {
+		err = m.errs[""]
+	}
+	rows := m.tables[table]
+	m.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateExistsQuery(table, column, wheres); err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[any]bool, len(values))
+	for _, v := range values {
+		if v != nil {
+			wanted[NormalizeDBKey(v)] = true
+		}
+	}
+
+	result := make(map[any]map[string]any)
+	for _, row := range rows {
+		val, ok := rowValue(row, column)
+		if !ok || val == nil {
+			continue
+		}
+		key := NormalizeDBKey(val)
+		if !wanted[key] || !matchWheres(row, wheres) {
+			continue
+		}
+		loaded := make(map[string]any, len(row))
+		for col, v := range row {
+			loaded[col] = v
+		}
+		result[key] = loaded
+	}
+	return result, nil
+}
+
+// rowValue looks up a column, accepting table-qualified names
+func rowValue(row map[string]any, column string) (any, bool) {
+	if v, ok := row[column]; ok {
+		return v, true
+	}
+	if i := strings.LastIndexByte(column, '.'); i >= 0 {
+		v, ok := row[column[i+1:]]
+		return v, ok
+	}
+	return nil, false
+}
+
+// matchWheres reports whether a row satisfies all clauses
+func matchWheres(row map[string]any, wheres []WhereClause) bool {
+	for _, w := range wheres {
+		if !matchWhere(row, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchWhere evaluates a validated clause against a row with SQL semantics
+func matchWhere(row map[string]any, w WhereClause) bool {
+	op := normalizeOperator(w.Operator)
+
+	if op == "OR" {
+		for _, sub := range w.Or {
+			if matchWhere(row, sub) {
+				return true
+			}
+		}
+		return false
+	}
+
+	val, _ := rowValue(row, w.Column)
+
+	switch op {
+	case "IS NULL":
+		return val == nil
+	case "IS NOT NULL":
+		return val != nil
+	}
+
+	switch op {
+	case "IN", "NOT IN":
+		list, _ := toAnySlice(w.Value)
+		if len(list) == 0 {
+			// Matches renderWhere: empty IN is 1=0, empty NOT IN is 1=1
+			return op == "NOT IN"
+		}
+		if val == nil {
+			return false
+		}
+		hasNull := false
+		for _, item := range list {
+			if item == nil {
+				hasNull = true
+				continue
+			}
+			if c, ok := compareDBValues(val, item); ok && c == 0 {
+				return op == "IN"
+			}
+		}
+		// x NOT IN (..., NULL) is NULL in SQL
+		return op == "NOT IN" && !hasNull
+	}
+
+	if val == nil {
+		return false
+	}
+
+	switch op {
+	case "BETWEEN", "NOT BETWEEN":
+		bounds, _ := toAnySlice(w.Value)
+		lo, okLo := compareDBValues(val, bounds[0])
+		hi, okHi := compareDBValues(val, bounds[1])
+		if !okLo || !okHi {
+			return false
+		}
+		return (lo >= 0 && hi <= 0) == (op == "BETWEEN")
+	case "LIKE", "NOT LIKE":
+		pattern, ok := w.Value.(string)
+		str, isStr := NormalizeDBKey(val).(string)
+		if !isStr {
+			str, isStr = val.(string)
+		}
+		if !ok || !isStr {
+			return false
+		}
+		return likeRegexp(pattern).MatchString(str) == (op == "LIKE")
+	}
+
+	if w.Value == nil {
+		return false
+	}
+	c, ok := compareDBValues(val, w.Value)
+	if !ok {
+		// Values of different kinds are never equal
+		return op == "!=" || op == "<>"
+	}
+
+	switch op {
+	case "=":
+		return c == 0
+	case "!=", "<>":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+// compareDBValues compares two values after NormalizeDBKey; ok is false when
+// they are not comparable (e.g., a number and a word)
+func compareDBValues(a, b any) (int, bool) {
+	if ta, ok := a.(time.Time); ok {
+		if tb, ok := b.(time.Time); ok {
+			return ta.Compare(tb), true
+		}
+	}
+
+	na, nb := NormalizeDBKey(a), NormalizeDBKey(b)
+
+	if fa, ok := dbNumber(na); ok {
+		if fb, ok := dbNumber(nb); ok {
+			if ia, ok := na.(int64); ok {
+				if ib, ok := nb.(int64); ok {
+					return compareOrdered(ia, ib), true
+				}
+			}
+			return compareOrdered(fa, fb), true
+		}
+		return 0, false
+	}
+
+	switch va := na.(type) {
+	case string:
+		if vb, ok := nb.(string); ok {
+			return strings.Compare(va, vb), true
+		}
+	case bool:
+		if vb, ok := nb.(bool); ok {
+			if va == vb {
+				return 0, true
+			}
+			if !va {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+
+	if na == nb {
+		return 0, true
+	}
+	return 0, false
+}
+
+func dbNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// likeRegexp converts a SQL LIKE pattern (% and _, backslash escapes) to a
+// regular expression
+func likeRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("(?s)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	re, _ := globalRegexCache.GetOrCompile(sb.String())
+	return re
+}
@@ -0,0 +1,167 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestMemoryChecker() *MemoryChecker {
+	return NewMemoryChecker(map[string][]map[string]any{
+		"users": {
+			{"id": 1, "email": "a@example.com", "status": "active", "role": "admin", "age": 30, "deleted_at": nil, "tenant_id": 1},
+			{"id": 2, "email": "b@example.com", "status": "banned", "role": "user", "age": 17, "deleted_at": "2024-01-01", "tenant_id": 1},
+			{"id": 3, "email": "c@test.org", "status": "active", "role": "user", "age": 45, "tenant_id": 2},
+		},
+	})
+}
+
+func TestMemoryChecker_Operators(t *testing.T) {
+	m := newTestMemoryChecker()
+	ids := []any{float64(1), float64(2), float64(3), float64(4)}
+
+	tests := []struct {
+		name   string
+		wheres []WhereClause
+		want   []int64
+	}{
+		{"none", nil, []int64{1, 2, 3}},
+		{"eq", []WhereClause{WhereEq("status", "active")}, []int64{1, 3}},
+		{"not eq", []WhereClause{WhereNot("status", "active")}, []int64{2}},
+		{"gt", []WhereClause{Where("age", ">", 18)}, []int64{1, 3}},
+		{"lte", []WhereClause{Where("age", "<=", 30)}, []int64{1, 2}},
+		{"in", []WhereClause{WhereIn("role", "admin", "owner")}, []int64{1}},
+		{"not in", []WhereClause{WhereNotIn("role", "admin")}, []int64{2, 3}},
+		{"empty in", []WhereClause{WhereIn("role")}, nil},
+		{"empty not in", []WhereClause{WhereNotIn("role")}, []int64{1, 2, 3}},
+		{"is null", []WhereClause{WhereNull("deleted_at")}, []int64{1, 3}},
+		{"is not null", []WhereClause{WhereNotNull("deleted_at")}, []int64{2}},
+		{"like", []WhereClause{WhereLike("email", "%@example.com")}, []int64{1, 2}},
+		{"not like", []WhereClause{Where("email", "NOT LIKE", "_@example.com")}, []int64{3}},
+		{"between", []WhereClause{WhereBetween("age", 18, 45)}, []int64{1, 3}},
+		{"not between", []WhereClause{Where("age", "NOT BETWEEN", []any{18, 45})}, []int64{2}},
+		{"or", []WhereClause{WhereOr(WhereEq("role", "admin"), Where("age", "<", 18))}, []int64{1, 2}},
+		{"null never equals", []WhereClause{WhereNot("deleted_at", "2024-01-01")}, nil},
+		{"combined", []WhereClause{WhereEq("status", "active"), WhereEq("tenant_id", 1)}, []int64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := m.CheckExists(context.Background(), "users", "id", ids, tt.wheres)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(result) != len(tt.want) {
+				t.Fatalf("Result = %v, want %v", result, tt.want)
+			}
+			for _, id := range tt.want {
+				if !result[id] {
+					t.Errorf("Expected id %d in result %v", id, result)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryChecker_RejectsInvalidQueries(t *testing.T) {
+	m := newTestMemoryChecker()
+
+	_, err := m.CheckExists(context.Background(), "users; DROP TABLE users", "id", []any{1}, nil)
+	if !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("Expected ErrInvalidIdentifier, got %v", err)
+	}
+
+	_, err = m.CheckExists(context.Background(), "users", "id", []any{1}, []WhereClause{Where("age", "~", 1)})
+	if !errors.Is(err, ErrInvalidOperator) {
+		t.Errorf("Expected ErrInvalidOperator, got %v", err)
+	}
+}
+
+func TestMemoryChecker_FromJSON(t *testing.T) {
+	m, err := NewMemoryCheckerFromJSON([]byte(`{
+		"countries": [{"code": "us"}, {"code": "de"}],
+		"plans": [{"id": 9007199254740993, "active": true}]
+	}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data := DataObject{"country": "us", "plan": int64(9007199254740993)}
+	schema := Schema{
+		"country": String().Exists("countries", "code"),
+		"plan":    Int().Exists("plans", "id", WhereEq("active", true)),
+	}
+	if verr := ValidateWithDB(context.Background(), data, schema, m); verr != nil {
+		t.Errorf("Unexpected errors: %v", verr.Errors)
+	}
+
+	if _, err := NewMemoryCheckerFromJSON([]byte(`{"bad": 1}`)); err == nil {
+		t.Error("Expected error for invalid fixtures")
+	}
+}
+
+func TestMemoryChecker_RecordsQueries(t *testing.T) {
+	m := newTestMemoryChecker()
+
+	data := DataObject{
+		"email":    "new@example.com",
+		"user_ids": []any{float64(1), float64(2), float64(3)},
+	}
+	schema := Schema{
+		"email":    String().Unique("users", "email", nil),
+		"user_ids": Array().Exists("users", "id"),
+	}
+
+	if verr := ValidateWithDB(context.Background(), data, schema, m); verr != nil {
+		t.Fatalf("Unexpected errors: %v", verr.Errors)
+	}
+
+	queries := m.Queries()
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 queries, got %d", len(queries))
+	}
+	for _, q := range queries {
+		switch q.Column {
+		case "email":
+			if !q.Unique || q.BatchSize != 1 {
+				t.Errorf("Unexpected email query: %+v", q)
+			}
+		case "id":
+			if q.Unique || q.BatchSize != 3 || len(q.Values) != 3 {
+				t.Errorf("Unexpected id query: %+v", q)
+			}
+		}
+	}
+
+	m.ResetQueries()
+	if m.QueryCount() != 0 {
+		t.Error("Expected queries to be cleared")
+	}
+}
+
+func TestMemoryChecker_SimulatesErrorsAndLatency(t *testing.T) {
+	m := newTestMemoryChecker()
+	boom := errors.New("boom")
+
+	m.FailWith("users", boom)
+	if _, err := m.CheckExists(context.Background(), "users", "id", []any{1}, nil); !errors.Is(err, boom) {
+		t.Errorf("Expected boom, got %v", err)
+	}
+	if _, err := m.CheckExists(context.Background(), "posts", "id", []any{1}, nil); err != nil {
+		t.Errorf("Expected other tables to work, got %v", err)
+	}
+
+	m.FailWith("users", nil).FailWith("", boom)
+	if _, err := m.CheckExists(context.Background(), "posts", "id", []any{1}, nil); !errors.Is(err, boom) {
+		t.Errorf("Expected boom for every table, got %v", err)
+	}
+	m.FailWith("", nil)
+
+	m.WithLatency(time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := m.CheckExists(ctx, "users", "id", []any{1}, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
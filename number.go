@@ -247,6 +247,34 @@ func (v *NumberValidator[T]) ExistsWithMessage(message MessageArg, table, column
 	return v
 }
 
+// ExistsAndLoad adds a database existence check that also loads the matched
+// row; use ValidateAndLoad to read it. The DBChecker must implement DBLoader.
+func (v *NumberValidator[T]) ExistsAndLoad(table, column string, wheres ...WhereClause) *NumberValidator[T] {
+	v.exists = &ExistsRule{Table: table, Column: column, Where: wheres, Load: true}
+	return v
+}
+
+// ExistsQuery adds a database existence check using a raw SQL template,
+// replacing Exists. ? binds args; {values} batches all values into one IN list
+// (the query must select the matching values first), while {value} runs the
+// query once per value and passes when it returns a row. The DBChecker must
+// implement RawQuerier. With Options.DBScope set, include the scope
+// conditions in the SQL and mark the rule Unscoped(); otherwise validation
+// fails with ErrScopeNotApplied.
+func (v *NumberValidator[T]) ExistsQuery(sql string, args ...any) *NumberValidator[T] {
+	v.exists = &ExistsRule{Query: &RawQuery{SQL: sql, Args: args}}
+	return v
+}
+
+// ExistsFunc adds a database existence check using a callback that receives
+// every value of this rule in one batch, replacing Exists. With
+// Options.DBScope set, apply DBScopeWhere(ctx, table) in the callback and mark
+// the rule Unscoped(); otherwise validation fails with ErrScopeNotApplied.
+func (v *NumberValidator[T]) ExistsFunc(fn ExistsFunc) *NumberValidator[T] {
+	v.exists = newExistsFuncRule(fn)
+	return v
+}
+
 // Unique adds database uniqueness check
 func (v *NumberValidator[T]) Unique(table, column string, ignore any, wheres ...WhereClause) *NumberValidator[T] {
 	v.unique = &UniqueRule{Table: table, Column: column, Ignore: ignore, Where: wheres}
@@ -260,6 +288,29 @@ func (v *NumberValidator[T]) UniqueWithMessage(message MessageArg, table, column
 	return v
 }
 
+// IgnoreWhere excludes the row where column equals value from the Unique
+// check (e.g., IgnoreWhere("id", currentID) on update). Must follow Unique;
+// a nil value disables the exclusion.
+func (v *NumberValidator[T]) IgnoreWhere(column string, value any) *NumberValidator[T] {
+	if v.unique != nil {
+		v.unique.IgnoreColumn = column
+		v.unique.IgnoreValue = value
+	}
+	return v
+}
+
+// Unscoped exempts the Exists and Unique rules defined before it from
+// Options.DBScope (e.g., a global lookup table without tenant_id)
+func (v *NumberValidator[T]) Unscoped() *NumberValidator[T] {
+	if v.exists != nil {
+		v.exists.Unscoped = true
+	}
+	if v.unique != nil {
+		v.unique.Unscoped = true
+	}
+	return v
+}
+
 // Custom adds custom validation function
 func (v *NumberValidator[T]) Custom(fn func(value T, lookup Lookup) error) *NumberValidator[T] {
 	v.customFn = fn
@@ -339,11 +390,13 @@ func (v *NumberValidator[T]) Validate(ctx *ValidationContext, value any) map[str
 		Index: extractIndex(fieldPath),
 		Value: value,
 		Data:  DataAccessor(ctx.RootData),
+		rec:   ctx.rec,
 	}
 
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.defaultValue != nil {
@@ -358,6 +411,7 @@ func (v *NumberValidator[T]) Validate(ctx *ValidationContext, value any) map[str
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		} else {
+			ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 			return nil
 		}
 	}
@@ -381,6 +435,8 @@ func (v *NumberValidator[T]) Validate(ctx *ValidationContext, value any) map[str
 	// Update msgCtx with actual value
 	msgCtx.Value = num
 
+	ctx.enterRules()
+
 	// Min
 	if v.minSet && num < v.min {
 		msgCtx.Param = v.min
@@ -532,7 +588,7 @@ func (v *NumberValidator[T]) Validate(ctx *ValidationContext, value any) map[str
 		lookup := func(path string) LookupResult {
 			return lookupPath(ctx.RootData, path)
 		}
-		if err := v.customFn(num, lookup); err != nil {
+		if err := ctx.runCustom(func() error { return v.customFn(num, lookup) }); err != nil {
 			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error(), msgCtx))
 		}
 	}
@@ -566,7 +622,7 @@ func (v *NumberValidator[T]) GetDBChecks(fieldPath string, value any) []DBCheck
 		checks = append(checks, DBCheck{
 			Field:    fieldPath,
 			Value:    num,
-			Rule:     ExistsRule{Table: v.unique.Table, Column: v.unique.Column, Where: v.unique.Where},
+			Rule:     ExistsRule{Table: v.unique.Table, Column: v.unique.Column, Where: v.unique.queryWheres(), Unscoped: v.unique.Unscoped},
 			IsUnique: true,
 			Ignore:   v.unique.Ignore,
 			Message:  v.messages["unique"],
@@ -577,11 +633,91 @@ func (v *NumberValidator[T]) GetDBChecks(fieldPath string, value any) []DBCheck
 }
 
 func (v *NumberValidator[T]) msg(rule, defaultMsg string, msgCtx MessageContext) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
 		msgCtx.Rule = rule
-		return resolveMessage(msg, msgCtx)
+		message = resolveMessage(msg, msgCtx)
+	}
+	msgCtx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *NumberValidator[T]) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	if v.defaultValue != nil {
+		rules = append(rules, ruleDesc{name: "default", param: *v.defaultValue, whenNil: true})
+	}
+	if v.coerce {
+		rules = append(rules, ruleDesc{name: "coerce", transform: true})
+	}
+	rules = append(rules, ruleDesc{name: "type", param: "number"})
+	if v.minSet {
+		rules = append(rules, ruleDesc{name: "min", param: v.min})
+	}
+	if v.maxSet {
+		rules = append(rules, ruleDesc{name: "max", param: v.max})
+	}
+	if v.positive {
+		rules = append(rules, ruleDesc{name: "positive"})
+	}
+	if v.negative {
+		rules = append(rules, ruleDesc{name: "negative"})
+	}
+	if v.multipleSet {
+		rules = append(rules, ruleDesc{name: "multipleOf", param: v.multipleOf})
+	}
+	if v.integer {
+		rules = append(rules, ruleDesc{name: "integer"})
+	}
+	if len(v.in) > 0 {
+		rules = append(rules, ruleDesc{name: "in", param: v.in})
+	}
+	if len(v.notIn) > 0 {
+		rules = append(rules, ruleDesc{name: "notIn", param: v.notIn})
+	}
+	if v.minDigitsSet {
+		rules = append(rules, ruleDesc{name: "minDigits", param: v.minDigits})
+	}
+	if v.maxDigitsSet {
+		rules = append(rules, ruleDesc{name: "maxDigits", param: v.maxDigits})
+	}
+	if v.regex != nil {
+		rules = append(rules, ruleDesc{name: "regex", param: v.regex.String()})
+	}
+	if v.notRegex != nil {
+		rules = append(rules, ruleDesc{name: "notRegex", param: v.notRegex.String()})
+	}
+	if v.lessThan != "" {
+		rules = append(rules, ruleDesc{name: "lessThan", param: v.lessThan})
+	}
+	if v.greaterThan != "" {
+		rules = append(rules, ruleDesc{name: "greaterThan", param: v.greaterThan})
+	}
+	if v.lessThanOrEq != "" {
+		rules = append(rules, ruleDesc{name: "lessThanOrEqual", param: v.lessThanOrEq})
+	}
+	if v.greaterThanOrEq != "" {
+		rules = append(rules, ruleDesc{name: "greaterThanOrEqual", param: v.greaterThanOrEq})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	return append(rules, dbRuleDescs(v.exists, v.unique)...)
+}
+
+// Describe returns a read-only description of the configured rules. Type is
+// "integer" for integer type parameters (Int) and "number" for floats.
+func (v *NumberValidator[T]) Describe() Description {
+	typ := "integer"
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64:
+		typ = "number"
 	}
-	return defaultMsg
+	d := newDescription(typ, v.nullable, v.describeRules(), v.messages)
+	d.DB = describeDBRules(v.exists, v.unique)
+	return d
 }
 
 // toNumber converts any numeric type to target type
@@ -268,11 +268,13 @@ func (v *ObjectValidator) Validate(ctx *ValidationContext, value any) map[string
 		Index: extractIndex(fieldPath),
 		Value: value,
 		Data:  DataAccessor(ctx.RootData),
+		rec:   ctx.rec,
 	}
 
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.required {
@@ -290,6 +292,7 @@ func (v *ObjectValidator) Validate(ctx *ValidationContext, value any) map[string
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		}
+		ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 		return nil
 	}
 
@@ -301,6 +304,8 @@ func (v *ObjectValidator) Validate(ctx *ValidationContext, value any) map[string
 		return errors
 	}
 
+	ctx.enterRules()
+
 	// Strict mode - check for unknown keys
 	if v.strict && v.schema != nil {
 		for key := range obj {
@@ -320,10 +325,11 @@ func (v *ObjectValidator) Validate(ctx *ValidationContext, value any) map[string
 				RootData: ctx.RootData,
 				Path:     append(ctx.Path, key),
 				Options:  ctx.Options,
+				reports:  ctx.reports,
 			}
 
 			childValue := obj[key]
-			childErrors := validator.Validate(childCtx, childValue)
+			childErrors := validateField(childCtx, validator, childValue)
 			// Merge child errors
 			for path, errs := range childErrors {
 				errors[path] = append(errors[path], errs...)
@@ -336,7 +342,7 @@ func (v *ObjectValidator) Validate(ctx *ValidationContext, value any) map[string
 		lookup := func(path string) LookupResult {
 			return lookupPath(ctx.RootData, path)
 		}
-		if err := v.customFn(obj, lookup); err != nil {
+		if err := ctx.runCustom(func() error { return v.customFn(obj, lookup) }); err != nil {
 			msgCtx.Rule = "custom"
 			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error(), msgCtx))
 		}
@@ -349,10 +355,36 @@ func (v *ObjectValidator) Validate(ctx *ValidationContext, value any) map[string
 }
 
 func (v *ObjectValidator) msg(rule, defaultMsg string, msgCtx MessageContext) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return resolveMessage(msg, msgCtx)
+		message = resolveMessage(msg, msgCtx)
+	}
+	msgCtx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain.
+// Nested fields are traced on their own paths.
+func (v *ObjectValidator) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	rules = append(rules, ruleDesc{name: "type", param: "object"})
+	if v.strict {
+		rules = append(rules, ruleDesc{name: "strict"})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	return rules
+}
+
+// Describe returns a read-only description of the configured rules, with the
+// shape in Fields
+func (v *ObjectValidator) Describe() Description {
+	d := newDescription("object", v.nullable, v.describeRules(), v.messages)
+	if v.schema != nil {
+		d.Fields = DescribeSchema(v.schema)
 	}
-	return defaultMsg
+	return d
 }
 
 // GetDBChecks returns database checks from nested schema validators
@@ -0,0 +1,137 @@
+package valet
+
+import "strings"
+
+// OpenAPIValidationError is the component name of valet's error response
+// schema: field paths mapped to their messages, as in ValidationError.Errors
+const OpenAPIValidationError = "ValidationError"
+
+// OpenAPISchema exports a schema as an OpenAPI 3.1 component schema. It is
+// the ToJSONSchema output without $schema, with File fields as binary
+// strings: format binary, contentMediaType for a single MIME type and
+// x-valet-min-size / x-valet-max-size hints in bytes.
+func OpenAPISchema(schema Schema) map[string]any {
+	return jsonSchemaExporter{openAPI: true}.object(DescribeSchema(schema), false)
+}
+
+// OpenAPIComponents builds an OpenAPI components object with a schema per
+// name plus the ValidationError schema
+func OpenAPIComponents(schemas map[string]Schema) map[string]any {
+	out := make(map[string]any, len(schemas)+1)
+	for name, schema := range schemas {
+		out[name] = OpenAPISchema(schema)
+	}
+	out[OpenAPIValidationError] = OpenAPIValidationErrorSchema()
+	return map[string]any{"schemas": out}
+}
+
+// OpenAPIRequestBody builds a request body referencing the named component.
+// Schemas with File fields use multipart/form-data with a content type
+// encoding per file field; others use application/json.
+func OpenAPIRequestBody(name string, schema Schema) map[string]any {
+	media := map[string]any{"schema": openAPIRef(name)}
+	contentType := "application/json"
+	if encoding, hasFiles := openAPIEncoding(DescribeSchema(schema)); hasFiles {
+		contentType = "multipart/form-data"
+		if len(encoding) > 0 {
+			media["encoding"] = encoding
+		}
+	}
+	return map[string]any{
+		"required": true,
+		"content":  map[string]any{contentType: media},
+	}
+}
+
+// OpenAPIOperation builds the requestBody and 422 response of an operation
+// validated with the named schema; add the success responses to it
+func OpenAPIOperation(name string, schema Schema) map[string]any {
+	return map[string]any{
+		"requestBody": OpenAPIRequestBody(name, schema),
+		"responses": map[string]any{
+			"422": OpenAPIValidationErrorResponse(),
+		},
+	}
+}
+
+// OpenAPIValidationErrorSchema describes valet's error format, e.g.
+// {"email": ["email is required"], "items.0.qty": ["qty must be at least 1"]}
+func OpenAPIValidationErrorSchema() map[string]any {
+	return map[string]any{
+		"type":        "object",
+		"description": "Validation errors by field path",
+		"additionalProperties": map[string]any{
+			"type":  "array",
+			"items": map[string]any{"type": "string"},
+		},
+	}
+}
+
+// OpenAPIValidationErrorResponse is a 422 response referencing the
+// ValidationError component
+func OpenAPIValidationErrorResponse() map[string]any {
+	return map[string]any{
+		"description": "Validation failed",
+		"content": map[string]any{
+			"application/json": map[string]any{"schema": openAPIRef(OpenAPIValidationError)},
+		},
+	}
+}
+
+// openAPIRef references a component schema
+func openAPIRef(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// openAPIFileSchema converts file rules to a binary string
+func openAPIFileSchema(out map[string]any, rules []RuleDescription, unsupported func(RuleDescription)) {
+	out["type"] = "string"
+	out["format"] = "binary"
+	for _, r := range rules {
+		switch r.Name {
+		case "min":
+			out["x-valet-min-size"] = r.Param
+		case "max":
+			out["x-valet-max-size"] = r.Param
+		case "mimes":
+			if mimes := r.Param.([]string); len(mimes) == 1 {
+				out["contentMediaType"] = mimes[0]
+			}
+		default:
+			unsupported(r)
+		}
+	}
+}
+
+// openAPIEncoding builds multipart encodings for top-level file fields and
+// file arrays, reporting whether the schema has any file field
+func openAPIEncoding(fields map[string]Description) (map[string]any, bool) {
+	encoding := make(map[string]any)
+	hasFiles := false
+	for name, d := range fields {
+		if d.Type == "array" && d.Element != nil {
+			d = *d.Element
+		}
+		if d.Type != "file" {
+			continue
+		}
+		hasFiles = true
+		if contentType := fileContentType(d.Rules); contentType != "" {
+			encoding[name] = map[string]any{"contentType": contentType}
+		}
+	}
+	return encoding, hasFiles
+}
+
+// fileContentType lists the accepted MIME types of a file field
+func fileContentType(rules []RuleDescription) string {
+	for _, r := range rules {
+		switch r.Name {
+		case "mimes":
+			return strings.Join(r.Param.([]string), ", ")
+		case "image":
+			return "image/*"
+		}
+	}
+	return ""
+}
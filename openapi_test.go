@@ -0,0 +1,85 @@
+package valet
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOpenAPISchema_Files(t *testing.T) {
+	out := OpenAPISchema(Schema{
+		"title":  String().Required(),
+		"avatar": File().Required().Max(2 << 20).Mimes("image/png"),
+		"docs":   Array().Of(File().Mimes("application/pdf", "text/plain")),
+	})
+
+	if _, ok := out["$schema"]; ok {
+		t.Error("Component schemas should not have $schema")
+	}
+	avatar := property(t, out, "avatar")
+	if avatar["type"] != "string" || avatar["format"] != "binary" || avatar["contentMediaType"] != "image/png" || avatar["x-valet-max-size"] != int64(2<<20) {
+		t.Errorf("avatar = %v", avatar)
+	}
+	if _, ok := avatar[JSONSchemaExtType]; ok {
+		t.Errorf("avatar should not have %s: %v", JSONSchemaExtType, avatar)
+	}
+	items, _ := property(t, out, "docs")["items"].(map[string]any)
+	if items["format"] != "binary" {
+		t.Errorf("docs items = %v", items)
+	}
+}
+
+func TestOpenAPIRequestBody(t *testing.T) {
+	body := OpenAPIRequestBody("CreateUser", Schema{"email": String().Required().Email()})
+	content := body["content"].(map[string]any)
+	media, ok := content["application/json"].(map[string]any)
+	if !ok || body["required"] != true {
+		t.Fatalf("body = %v", body)
+	}
+	if ref := media["schema"].(map[string]any)["$ref"]; ref != "#/components/schemas/CreateUser" {
+		t.Errorf("$ref = %v", ref)
+	}
+
+	body = OpenAPIRequestBody("Upload", Schema{
+		"avatar": File().Image(),
+		"docs":   Array().Of(File().Mimes("application/pdf", "text/plain")),
+		"note":   String(),
+	})
+	content = body["content"].(map[string]any)
+	media, ok = content["multipart/form-data"].(map[string]any)
+	if !ok {
+		t.Fatalf("Expected multipart body: %v", body)
+	}
+	encoding := media["encoding"].(map[string]any)
+	if len(encoding) != 2 ||
+		encoding["avatar"].(map[string]any)["contentType"] != "image/*" ||
+		encoding["docs"].(map[string]any)["contentType"] != "application/pdf, text/plain" {
+		t.Errorf("encoding = %v", encoding)
+	}
+}
+
+func TestOpenAPIOperationAndComponents(t *testing.T) {
+	schema := Schema{"name": String().Required()}
+	op := OpenAPIOperation("CreateTag", schema)
+	resp := op["responses"].(map[string]any)["422"].(map[string]any)
+	media := resp["content"].(map[string]any)["application/json"].(map[string]any)
+	if media["schema"].(map[string]any)["$ref"] != "#/components/schemas/"+OpenAPIValidationError {
+		t.Errorf("422 response = %v", resp)
+	}
+
+	components := OpenAPIComponents(map[string]Schema{"CreateTag": schema})
+	schemas := components["schemas"].(map[string]any)
+	if len(schemas) != 2 || schemas["CreateTag"] == nil || schemas[OpenAPIValidationError] == nil {
+		t.Errorf("components = %v", components)
+	}
+
+	// Validation errors marshal to the documented shape
+	verr := Validate(DataObject{}, schema)
+	b, _ := json.Marshal(verr.Errors)
+	var decoded map[string][]string
+	if err := json.Unmarshal(b, &decoded); err != nil || len(decoded["name"]) != 1 {
+		t.Errorf("Errors = %s", b)
+	}
+	if _, err := json.Marshal(components); err != nil {
+		t.Errorf("Marshal error: %v", err)
+	}
+}
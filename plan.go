@@ -0,0 +1,158 @@
+package valet
+
+import "context"
+
+// QueryPlan describes the DB checks a payload would trigger, without running
+// them. It is returned by Plan.
+type QueryPlan struct {
+	// Batches are the query groups in the order they would be executed
+	Batches []PlannedBatch
+	// Errors are the local validation errors by field path
+	Errors map[string][]string
+}
+
+// PlannedBatch is a group of checks that share a single batched lookup
+type PlannedBatch struct {
+	Key    string // Batch key used to group the checks
+	Table  string
+	Column string
+	Where  []WhereClause // Where clauses after DBScope and WhereField resolution
+	Query  *RawQuery     // Set for ExistsQuery groups
+	Func   bool          // True for ExistsFunc groups (no query can be rendered)
+	Unique bool          // True when the group contains Unique checks
+	Load   bool          // True when the group loads rows (ExistsAndLoad)
+	Checks []DBCheck
+	Values []any
+	// Queries are the statements rendered with the checker's dialect. They are
+	// empty for ExistsFunc groups and for checkers that do not expose a
+	// Dialect (e.g., MemoryChecker, DocumentAdapter).
+	Queries []PlannedQuery
+	// Err is set when the group's query cannot be rendered (e.g., an invalid
+	// identifier or raw query template) or DBScope cannot be applied to it
+	// (ErrScopeNotApplied)
+	Err error
+}
+
+// PlannedQuery is a single rendered statement with its bind arguments
+type PlannedQuery struct {
+	SQL  string
+	Args []any
+}
+
+// HasErrors reports whether local validation failed
+func (p *QueryPlan) HasErrors() bool {
+	return len(p.Errors) > 0
+}
+
+// QueryCount returns the number of statements the plan would execute
+func (p *QueryPlan) QueryCount() int {
+	n := 0
+	for _, b := range p.Batches {
+		n += len(b.Queries)
+	}
+	return n
+}
+
+// Plan runs local validation and returns the DB checks that Validate would
+// execute, grouped exactly as they would be batched, without querying the
+// database. SQL is rendered when Options.DBChecker exposes a Dialect (all SQL
+// adapters and CachingChecker wrapping one). Useful for debugging and for
+// pre-warming caches.
+func Plan(data DataObject, schema Schema, opts ...Options) *QueryPlan {
+	var options Options
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	if options.Context == nil {
+		options.Context = context.Background()
+	}
+
+	dbChecksPtr := getDBCheckSlice()
+	defer releaseDBCheckSlice(dbChecksPtr)
+
+	allErrors, aborted := validateFields(data, schema, &options, dbChecksPtr)
+	plan := &QueryPlan{Errors: allErrors}
+	if aborted {
+		return plan
+	}
+
+	checks, scopeErr := prepareDBChecks(*dbChecksPtr, allErrors, data, &options)
+	if len(checks) == 0 {
+		return plan
+	}
+
+	groups := groupDBChecks(checks)
+	defer func() {
+		for _, g := range groups {
+			releaseBatchGroup(g)
+		}
+	}()
+
+	var dialect Dialect
+	if dc, ok := options.DBChecker.(interface{ Dialect() Dialect }); ok {
+		dialect = dialectOrDefault(dc.Dialect())
+	}
+
+	plan.Batches = make([]PlannedBatch, 0, len(groups))
+	for _, g := range groups {
+		b := planBatch(g, dialect)
+		if scopeErr != nil && (g.query != nil || g.fn != nil) && !allUnscoped(g.checks) {
+			b.Err = ErrScopeNotApplied
+		}
+		plan.Batches = append(plan.Batches, b)
+	}
+	return plan
+}
+
+// allUnscoped reports whether every check is exempt from DBScope
+func allUnscoped(checks []DBCheck) bool {
+	for _, check := range checks {
+		if !check.Rule.Unscoped {
+			return false
+		}
+	}
+	return true
+}
+
+// planBatch copies a pooled group into a PlannedBatch and renders its queries
+func planBatch(g *batchGroup, d Dialect) PlannedBatch {
+	b := PlannedBatch{
+		Key:    g.key,
+		Table:  g.table,
+		Column: g.column,
+		Where:  g.wheres,
+		Query:  g.query,
+		Func:   g.fn != nil,
+		Unique: g.unique,
+		Load:   g.load,
+		Checks: append([]DBCheck(nil), g.checks...),
+		Values: append([]any(nil), g.values...),
+	}
+	if d == nil || b.Func {
+		return b
+	}
+
+	var (
+		queries []existsQuery
+		err     error
+	)
+	switch {
+	case g.query != nil:
+		if err = g.query.validate(); err == nil {
+			queries = renderRawQueries(d, g.query, g.values)
+		}
+	case g.load:
+		queries, err = buildLoadQueries(d, g.table, g.column, g.values, g.wheres)
+	default:
+		queries, err = buildExistsQueries(d, g.table, g.column, g.values, g.wheres)
+	}
+
+	b.Err = err
+	if len(queries) > 0 {
+		b.Queries = make([]PlannedQuery, len(queries))
+		for i, q := range queries {
+			b.Queries[i] = PlannedQuery{SQL: q.SQL, Args: q.Args}
+		}
+	}
+	return b
+}
@@ -0,0 +1,159 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPlan_GroupsChecksAndRendersSQL(t *testing.T) {
+	schema := Schema{
+		"user_id":  Float().Required().Exists("users", "id", WhereEq("active", true)),
+		"owner_id": Float().Required().Exists("users", "id", WhereEq("active", true)),
+		"email":    String().Required().Unique("users", "email", nil),
+	}
+	data := DataObject{"user_id": float64(1), "owner_id": float64(2), "email": "a@x.com"}
+	plan := Plan(data, schema, Options{
+		DBChecker: NewSQLAdapter(nil).WithDialect(PostgresDialect{}),
+	})
+
+	if plan.HasErrors() {
+		t.Fatalf("Unexpected errors: %v", plan.Errors)
+	}
+	if len(plan.Batches) != 2 {
+		t.Fatalf("Expected 2 batches, got %d", len(plan.Batches))
+	}
+
+	var exists, unique *PlannedBatch
+	for i := range plan.Batches {
+		if plan.Batches[i].Unique {
+			unique = &plan.Batches[i]
+		} else {
+			exists = &plan.Batches[i]
+		}
+	}
+	if exists == nil || unique == nil {
+		t.Fatalf("Expected one exists and one unique batch, got %+v", plan.Batches)
+	}
+
+	if len(exists.Checks) != 2 || len(exists.Values) != 2 {
+		t.Errorf("Expected both user checks in one batch, got %d checks", len(exists.Checks))
+	}
+	if len(exists.Queries) != 1 {
+		t.Fatalf("Expected 1 query, got %d", len(exists.Queries))
+	}
+	want := `SELECT "id" FROM "users" WHERE "id" IN ($1,$2) AND "active" = $3`
+	if exists.Queries[0].SQL != want {
+		t.Errorf("SQL = %q, want %q", exists.Queries[0].SQL, want)
+	}
+	if len(exists.Queries[0].Args) != 3 {
+		t.Errorf("Args = %v", exists.Queries[0].Args)
+	}
+	if unique.Queries[0].SQL != `SELECT "email" FROM "users" WHERE "email" IN ($1)` {
+		t.Errorf("Unique SQL = %q", unique.Queries[0].SQL)
+	}
+	if plan.QueryCount() != 2 {
+		t.Errorf("QueryCount = %d, want 2", plan.QueryCount())
+	}
+}
+
+func TestPlan_DoesNotQuery(t *testing.T) {
+	checker := NewMemoryChecker(map[string][]map[string]any{
+		"users": {{"id": 1}},
+	})
+	schema := Schema{"user_id": Float().Required().Exists("users", "id")}
+
+	plan := Plan(DataObject{"user_id": float64(1)}, schema, Options{DBChecker: checker})
+
+	if checker.QueryCount() != 0 {
+		t.Errorf("Expected no queries, got %d", checker.QueryCount())
+	}
+	if len(plan.Batches) != 1 {
+		t.Fatalf("Expected 1 batch, got %d", len(plan.Batches))
+	}
+	// MemoryChecker has no dialect, so no SQL is rendered
+	if len(plan.Batches[0].Queries) != 0 {
+		t.Errorf("Expected no rendered queries, got %v", plan.Batches[0].Queries)
+	}
+	if plan.Batches[0].Table != "users" || plan.Batches[0].Column != "id" {
+		t.Errorf("Unexpected batch: %+v", plan.Batches[0])
+	}
+}
+
+func TestPlan_LocalErrors(t *testing.T) {
+	schema := Schema{
+		"name":    String().Required(),
+		"user_id": Float().Required().Exists("users", "id"),
+	}
+	data := DataObject{"user_id": float64(1)}
+
+	plan := Plan(data, schema)
+	if !plan.HasErrors() || len(plan.Errors["name"]) == 0 {
+		t.Fatalf("Expected name error, got %v", plan.Errors)
+	}
+	if len(plan.Batches) != 0 {
+		t.Errorf("Expected no batches when local validation fails, got %d", len(plan.Batches))
+	}
+
+	plan = Plan(data, schema, Options{DBCheckMode: DBCheckPerField})
+	if len(plan.Batches) != 1 {
+		t.Errorf("Expected 1 batch with DBCheckPerField, got %d", len(plan.Batches))
+	}
+}
+
+func TestPlan_ScopeAndWhereFields(t *testing.T) {
+	schema := Schema{
+		"country_id": Float().Required(),
+		"city_id":    Float().Required().Exists("cities", "id", WhereField("country_id", "=", "country_id")),
+	}
+	data := DataObject{"country_id": float64(7), "city_id": float64(3)}
+
+	plan := Plan(data, schema, Options{
+		DBChecker: NewSQLAdapter(nil),
+		DBScope:   &DBScope{Where: []WhereClause{WhereEq("tenant_id", 42)}},
+	})
+	if len(plan.Batches) != 1 {
+		t.Fatalf("Expected 1 batch, got %d", len(plan.Batches))
+	}
+
+	want := "SELECT id FROM cities WHERE id IN (?) AND country_id = ? AND tenant_id = ?"
+	q := plan.Batches[0].Queries[0]
+	if q.SQL != want {
+		t.Errorf("SQL = %q, want %q", q.SQL, want)
+	}
+	if !reflect.DeepEqual(q.Args, []any{float64(3), float64(7), 42}) {
+		t.Errorf("Args = %v", q.Args)
+	}
+}
+
+func TestPlan_RawQueryFuncAndErrors(t *testing.T) {
+	schema := Schema{
+		"code":  String().Required().ExistsQuery("SELECT 1 FROM coupons WHERE code = {value} AND uses_left > ?", 0),
+		"sku":   String().Required().ExistsFunc(func(_ context.Context, _ DBChecker, _ []any) (map[any]bool, error) { return nil, nil }),
+		"badge": String().Required().Exists("badges; DROP", "id"),
+	}
+	data := DataObject{"code": "A", "sku": "S", "badge": "B"}
+
+	plan := Plan(data, schema, Options{DBChecker: &rawQuerier{dialect: PostgresDialect{}}})
+	if len(plan.Batches) != 3 {
+		t.Fatalf("Expected 3 batches, got %d", len(plan.Batches))
+	}
+
+	for _, b := range plan.Batches {
+		switch {
+		case b.Query != nil:
+			if len(b.Queries) != 1 || b.Queries[0].SQL != "SELECT 1 FROM coupons WHERE code = $1 AND uses_left > $2" {
+				t.Errorf("Raw query = %+v", b.Queries)
+			}
+		case b.Func:
+			if len(b.Queries) != 0 {
+				t.Errorf("Func batch should have no queries")
+			}
+		default:
+			if !errors.Is(b.Err, ErrInvalidIdentifier) {
+				t.Errorf("Expected ErrInvalidIdentifier, got %v", b.Err)
+			}
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package valet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	valuesToken = "{values}"
+	valueToken  = "{value}"
+)
+
+// RawQuery is an SQL template for ExistsQuery rules. ? binds Args in order,
+// {values} expands to the placeholders of a batch of validated values (the
+// query must return the matching values in its first column), and {value}
+// binds a single value (the value exists when any row is returned).
+type RawQuery struct {
+	SQL  string
+	Args []any
+}
+
+// ExistsFunc checks a batch of values and returns the ones that exist. It
+// receives the configured DBChecker so it can reuse the same connection.
+type ExistsFunc func(ctx context.Context, checker DBChecker, values []any) (map[any]bool, error)
+
+// RawQuerier is an optional DBChecker extension used by ExistsQuery rules.
+// QueryColumn runs a rendered query and returns the first column of every row.
+type RawQuerier interface {
+	Dialect() Dialect
+	QueryColumn(ctx context.Context, query string, args ...any) ([]any, error)
+}
+
+// batched reports whether the template uses a {values} list
+func (q *RawQuery) batched() bool {
+	_, values, _ := countRawTokens(q.SQL)
+	return values > 0
+}
+
+// key returns the batch key of the query
+func (q *RawQuery) key() string {
+	var sb strings.Builder
+	sb.WriteString("raw:")
+	sb.WriteString(q.SQL)
+	for _, a := range q.Args {
+		fmt.Fprintf(&sb, "\x00%T:%v", a, a)
+	}
+	return sb.String()
+}
+
+// validate checks that the template binds every arg and exactly one value token
+func (q *RawQuery) validate() error {
+	n, values, value := countRawTokens(q.SQL)
+	if values+value != 1 {
+		return fmt.Errorf("%w: query must contain exactly one %s or %s", ErrInvalidRawQuery, valuesToken, valueToken)
+	}
+	if n != len(q.Args) {
+		return fmt.Errorf("%w: query has %d ? placeholders but %d args", ErrInvalidRawQuery, n, len(q.Args))
+	}
+	return nil
+}
+
+// countRawTokens counts ?, {values} and {value} outside of quoted strings and
+// identifiers
+func countRawTokens(sql string) (args, values, value int) {
+	scanRawSQL(sql, func(int, string) { args++ }, func(_ int, token string) {
+		if token == valuesToken {
+			values++
+		} else {
+			value++
+		}
+	})
+	return args, values, value
+}
+
+// scanRawSQL walks the template outside of quotes, calling onArg for ? and
+// onToken for {values}/{value}
+func scanRawSQL(sql string, onArg func(i int, token string), onToken func(i int, token string)) {
+	var quote byte
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '?':
+			onArg(i, "?")
+		case strings.HasPrefix(sql[i:], valuesToken):
+			onToken(i, valuesToken)
+			i += len(valuesToken) - 1
+		case strings.HasPrefix(sql[i:], valueToken):
+			onToken(i, valueToken)
+			i += len(valueToken) - 1
+		}
+	}
+}
+
+// render replaces ? and the value token with dialect placeholders
+func (q *RawQuery) render(d Dialect, values []any) (string, []any) {
+	var sb strings.Builder
+	args := make([]any, 0, len(q.Args)+len(values))
+	last, next := 0, 0
+
+	write := func(i int, token string) {
+		sb.WriteString(q.SQL[last:i])
+		last = i + len(token)
+		if token == "?" {
+			sb.WriteString(d.Placeholder(len(args) + 1))
+			args = append(args, q.Args[next])
+			next++
+			return
+		}
+		for j, v := range values {
+			if j > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(d.Placeholder(len(args) + 1))
+			args = append(args, v)
+		}
+	}
+	scanRawSQL(q.SQL, write, write)
+	sb.WriteString(q.SQL[last:])
+	return sb.String(), args
+}
+
+// runRawQuery executes an ExistsQuery rule for a batch of values, chunking
+// {values} lists by the dialect's parameter limit and running {value}
+// templates once per value
+func runRawQuery(ctx context.Context, checker DBChecker, q *RawQuery, values []any) (map[any]bool, error) {
+	querier, ok := checker.(RawQuerier)
+	if !ok {
+		return nil, ErrRawQueryNotSupported
+	}
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	d := querier.Dialect()
+	if d == nil {
+		d = DefaultDialect
+	}
+	result := make(map[any]bool, len(values))
+	for i, rq := range renderRawQueries(d, q, values) {
+		rows, err := querier.QueryColumn(ctx, rq.SQL, rq.Args...)
+		if err != nil {
+			return nil, err
+		}
+		// {value} templates render one query per value, in order
+		if !q.batched() {
+			if len(rows) > 0 {
+				result[NormalizeDBKey(values[i])] = true
+			}
+			continue
+		}
+		for _, v := range rows {
+			result[NormalizeDBKey(v)] = true
+		}
+	}
+	return result, nil
+}
+
+// renderRawQueries renders the statements for a batch of values: one per
+// value for {value} templates, or {values} lists chunked by the dialect's
+// parameter limit
+func renderRawQueries(d Dialect, q *RawQuery, values []any) []existsQuery {
+	if !q.batched() {
+		queries := make([]existsQuery, len(values))
+		for i, v := range values {
+			sql, args := q.render(d, []any{v})
+			queries[i] = existsQuery{SQL: sql, Args: args}
+		}
+		return queries
+	}
+
+	chunkSize := len(values)
+	if limit := d.MaxParams(); limit > 0 && limit-len(q.Args) < chunkSize {
+		chunkSize = limit - len(q.Args)
+		if chunkSize < 1 {
+			chunkSize = 1
+		}
+	}
+
+	queries := make([]existsQuery, 0, (len(values)+chunkSize-1)/max(chunkSize, 1))
+	for start := 0; start < len(values); start += chunkSize {
+		end := start + chunkSize
+		if end > len(values) {
+			end = len(values)
+		}
+		sql, args := q.render(d, values[start:end])
+		queries = append(queries, existsQuery{SQL: sql, Args: args})
+	}
+	return queries
+}
@@ -0,0 +1,170 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// rawQuerier is a RawQuerier stand-in returning the bound values that appear
+// in existing, in the order they were bound
+type rawQuerier struct {
+	mu       sync.Mutex
+	dialect  Dialect
+	existing map[any]bool
+	queries  []string
+	args     [][]any
+}
+
+func (r *rawQuerier) CheckExists(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+	return nil, errors.New("unexpected CheckExists")
+}
+
+func (r *rawQuerier) Dialect() Dialect { return r.dialect }
+
+func (r *rawQuerier) QueryColumn(ctx context.Context, query string, args ...any) ([]any, error) {
+	r.mu.Lock()
+	r.queries = append(r.queries, query)
+	r.args = append(r.args, args)
+	r.mu.Unlock()
+
+	var rows []any
+	for _, a := range args {
+		if r.existing[NormalizeDBKey(a)] {
+			rows = append(rows, a)
+		}
+	}
+	return rows, nil
+}
+
+func TestRawQuery_Render(t *testing.T) {
+	q := &RawQuery{
+		SQL:  "SELECT code FROM coupons WHERE code IN ({values}) AND expires_at > ? AND note <> '?' AND uses_left > ?",
+		Args: []any{"2024-01-01", 0},
+	}
+	if err := q.validate(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	sql, args := q.render(PostgresDialect{}, []any{"A", "B"})
+	want := "SELECT code FROM coupons WHERE code IN ($1,$2) AND expires_at > $3 AND note <> '?' AND uses_left > $4"
+	if sql != want {
+		t.Errorf("SQL = %q, want %q", sql, want)
+	}
+	if !reflect.DeepEqual(args, []any{"A", "B", "2024-01-01", 0}) {
+		t.Errorf("Args = %v", args)
+	}
+}
+
+func TestRawQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		q    RawQuery
+	}{
+		{"no token", RawQuery{SQL: "SELECT 1 FROM t WHERE a = ?", Args: []any{1}}},
+		{"two tokens", RawQuery{SQL: "SELECT a FROM t WHERE a IN ({values}) OR b = {value}"}},
+		{"missing arg", RawQuery{SQL: "SELECT a FROM t WHERE a IN ({values}) AND b = ?"}},
+		{"extra arg", RawQuery{SQL: "SELECT a FROM t WHERE a IN ({values})", Args: []any{1}}},
+		{"quoted token", RawQuery{SQL: "SELECT a FROM t WHERE note = '{value}'"}},
+	}
+
+	for _, tt := range tests {
+		if err := tt.q.validate(); !errors.Is(err, ErrInvalidRawQuery) {
+			t.Errorf("%s: expected ErrInvalidRawQuery, got %v", tt.name, err)
+		}
+	}
+
+	q := RawQuery{SQL: "SELECT a FROM t WHERE a IN ({values}) AND note <> '{value}'"}
+	if err := q.validate(); err != nil {
+		t.Errorf("Expected quoted token to be ignored, got %v", err)
+	}
+	if !q.batched() {
+		t.Error("Expected query to be batched")
+	}
+}
+
+func TestExistsQuery_Batched(t *testing.T) {
+	db := &rawQuerier{dialect: SQLiteDialect{}, existing: map[any]bool{"SAVE10": true, "SAVE20": true}}
+
+	schema := Schema{
+		"coupons": Array().Of(String().ExistsQuery(
+			"SELECT code FROM coupons WHERE code IN ({values}) AND uses_left > ?", 0,
+		)),
+	}
+	data := DataObject{"coupons": []any{"SAVE10", "BOGUS", "SAVE20"}}
+
+	verr := ValidateWithDB(context.Background(), data, schema, db)
+	if verr == nil || len(verr.Errors) != 1 || len(verr.Errors["coupons.1"]) != 1 {
+		t.Fatalf("Expected only coupons.1 to fail, got %v", verr)
+	}
+	if len(db.queries) != 1 {
+		t.Fatalf("Expected 1 batched query, got %d", len(db.queries))
+	}
+	if !strings.Contains(db.queries[0], "IN (?,?,?) AND uses_left > ?") {
+		t.Errorf("Unexpected SQL: %s", db.queries[0])
+	}
+}
+
+func TestExistsQuery_PerValue(t *testing.T) {
+	db := &rawQuerier{dialect: PostgresDialect{}, existing: map[any]bool{int64(7): true}}
+
+	schema := Schema{
+		"a": Int().ExistsQuery("SELECT 1 FROM plans WHERE id = {value} AND active = ?", true),
+		"b": Int().ExistsQuery("SELECT 1 FROM plans WHERE id = {value} AND active = ?", true),
+	}
+	data := DataObject{"a": 7, "b": 8}
+
+	verr := ValidateWithDB(context.Background(), data, schema, db)
+	if verr == nil || len(verr.Errors) != 1 || len(verr.Errors["b"]) != 1 {
+		t.Fatalf("Expected only b to fail, got %v", verr)
+	}
+	// Same template batches into one group, executed once per value
+	if len(db.queries) != 2 {
+		t.Fatalf("Expected 2 queries, got %d", len(db.queries))
+	}
+	if db.queries[0] != "SELECT 1 FROM plans WHERE id = $1 AND active = $2" {
+		t.Errorf("Unexpected SQL: %s", db.queries[0])
+	}
+}
+
+func TestExistsQuery_RequiresRawQuerier(t *testing.T) {
+	checker := NewMemoryChecker(nil)
+	schema := Schema{"code": String().ExistsQuery("SELECT code FROM c WHERE code IN ({values})")}
+
+	verr := ValidateWithDB(context.Background(), DataObject{"code": "x"}, schema, checker)
+	if verr == nil || !errors.Is(verr, ErrRawQueryNotSupported) {
+		t.Errorf("Expected ErrRawQueryNotSupported, got %v", verr)
+	}
+}
+
+func TestExistsFunc(t *testing.T) {
+	var calls [][]any
+	fn := func(ctx context.Context, checker DBChecker, values []any) (map[any]bool, error) {
+		calls = append(calls, values)
+		if checker == nil {
+			t.Error("Expected the configured checker")
+		}
+		result := make(map[any]bool)
+		for _, v := range values {
+			if strings.HasPrefix(v.(string), "ok") {
+				result[v] = true
+			}
+		}
+		return result, nil
+	}
+
+	rule := String().ExistsFunc(fn)
+	schema := Schema{"codes": Array().Of(rule)}
+	data := DataObject{"codes": []any{"ok-1", "bad", "ok-2"}}
+
+	verr := ValidateWithDB(context.Background(), data, schema, NewMemoryChecker(nil))
+	if verr == nil || len(verr.Errors) != 1 || len(verr.Errors["codes.1"]) != 1 {
+		t.Fatalf("Expected only codes.1 to fail, got %v", verr)
+	}
+	if len(calls) != 1 || len(calls[0]) != 3 {
+		t.Errorf("Expected one batched call with 3 values, got %v", calls)
+	}
+}
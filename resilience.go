@@ -0,0 +1,295 @@
+package valet
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures retries of failed DB queries
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry; it doubles per retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries (0 = no cap)
+	MaxBackoff time.Duration
+	// Retryable decides whether an error is retried (nil = IsRetryableDBError)
+	Retryable func(error) bool
+}
+
+// backoff returns the wait before the given retry (1-based)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.InitialBackoff
+	for i := 1; i < retry && d > 0; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// retryable classifies err with Retryable or IsRetryableDBError; p may be nil
+func (p *RetryPolicy) retryable(err error) bool {
+	if p != nil && p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryableDBError(err)
+}
+
+// IsRetryableDBError reports whether err is a transient driver error: a bad
+// connection, a query timeout, or an error reporting Timeout() or Temporary()
+func IsRetryableDBError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return true
+	}
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+	return false
+}
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets queries through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects queries with ErrCircuitOpen until the cooldown ends
+	CircuitOpen
+	// CircuitHalfOpen lets a single trial query through
+	CircuitHalfOpen
+)
+
+// String returns the state name
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+// CircuitBreaker stops sending DB queries after consecutive transient
+// failures (as classified by the retry policy, or IsRetryableDBError), so a
+// down database fails validation fast instead of piling up requests
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// NewCircuitBreaker opens after threshold consecutive failures and allows a
+// trial query once cooldown has passed
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// State returns the current state
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == CircuitOpen && cb.now().Sub(cb.openedAt) >= cb.cooldown {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// Reset closes the breaker
+func (cb *CircuitBreaker) Reset() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.state = CircuitClosed
+	cb.failures = 0
+	cb.trial = false
+}
+
+// allow reports whether a query may run
+func (cb *CircuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.cooldown {
+			return false
+		}
+		cb.state = CircuitHalfOpen
+		cb.trial = true
+		return true
+	case CircuitHalfOpen:
+		if cb.trial {
+			return false
+		}
+		cb.trial = true
+		return true
+	}
+	return true
+}
+
+// record updates the breaker with the outcome of a query. Only transient
+// errors count as failures; other errors (invalid identifiers, unsupported
+// checkers, the caller's cancellation) say nothing about the database and
+// just release a half-open trial.
+func (cb *CircuitBreaker) record(err error, transient bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err == nil {
+		cb.state = CircuitClosed
+		cb.failures = 0
+		cb.trial = false
+		return
+	}
+
+	if !transient {
+		cb.trial = false
+		return
+	}
+
+	cb.failures++
+	if cb.state == CircuitHalfOpen || cb.failures >= cb.threshold {
+		cb.state = CircuitOpen
+		cb.openedAt = cb.now()
+		cb.trial = false
+	}
+}
+
+// runGroupQuery executes a batch group query with the per-query timeout,
+// retry policy and circuit breaker from opts, reporting it to Options.Hooks
+func runGroupQuery(ctx context.Context, checker DBChecker, g *batchGroup, opts *Options) batchResult {
+	if opts == nil || opts.Hooks == nil {
+		return runGroupAttempts(ctx, checker, g, opts)
+	}
+
+	start := time.Now()
+	result := runGroupAttempts(ctx, checker, g, opts)
+	opts.Hooks.OnDBBatch(ctx, DBBatchEvent{
+		Table:    g.table,
+		Column:   g.column,
+		Values:   len(g.values),
+		Unique:   g.unique,
+		Duration: time.Since(start),
+		Attempts: result.attempts,
+		Err:      result.err,
+	})
+	return result
+}
+
+// runGroupAttempts runs the group query until it succeeds or the retry
+// policy gives up
+func runGroupAttempts(ctx context.Context, checker DBChecker, g *batchGroup, opts *Options) batchResult {
+	var (
+		timeout time.Duration
+		retry   *RetryPolicy
+		breaker *CircuitBreaker
+	)
+	if opts != nil {
+		timeout, retry, breaker = opts.DBQueryTimeout, opts.DBRetry, opts.DBCircuitBreaker
+	}
+
+	if _, ok := checker.(RawQuerier); g.query != nil && !ok {
+		return batchResult{group: g, err: ErrRawQueryNotSupported}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if breaker != nil && !breaker.allow() {
+			return batchResult{group: g, err: ErrCircuitOpen, attempts: attempt - 1}
+		}
+
+		qctx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			qctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		result := queryGroup(qctx, checker, g)
+		result.attempts = attempt
+		cancel()
+
+		err := result.err
+		if breaker != nil {
+			// The breaker uses the retry classification; errors after the
+			// caller's context ended are not the database's fault
+			breaker.record(err, ctx.Err() == nil && retry.retryable(err))
+		}
+		if err == nil {
+			return result
+		}
+
+		// Give up when retries are exhausted, the caller's context is done or
+		// the error is permanent (e.g., invalid identifier, syntax error)
+		if retry == nil || attempt >= retry.MaxAttempts || ctx.Err() != nil || !retry.retryable(err) {
+			return result
+		}
+
+		if wait := retry.backoff(attempt); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return result
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// queryGroup runs a single attempt, loading rows when the group needs them
+func queryGroup(ctx context.Context, checker DBChecker, g *batchGroup) batchResult {
+	switch {
+	case g.fn != nil:
+		existsMap, err := g.fn(g.context(ctx), checker, g.values)
+		return batchResult{group: g, existsMap: existsMap, err: err}
+	case g.query != nil:
+		existsMap, err := runRawQuery(g.context(ctx), checker, g.query, g.values)
+		return batchResult{group: g, existsMap: existsMap, err: err}
+	}
+
+	loader, ok := checker.(DBLoader)
+	if g.load && ok {
+		rows, err := loader.LoadRows(g.context(ctx), g.table, g.column, g.values, g.wheres)
+		if err == nil {
+			existsMap := make(map[any]bool, len(rows))
+			for k := range rows {
+				existsMap[k] = true
+			}
+			return batchResult{group: g, existsMap: existsMap, rows: rows}
+		}
+		if !errors.Is(err, ErrLoadNotSupported) {
+			return batchResult{group: g, err: err}
+		}
+	}
+
+	// Without a loader the plain checks still run; only the ExistsAndLoad
+	// checks of the group fail
+	existsMap, err := checker.CheckExists(g.context(ctx), g.table, g.column, g.values, g.wheres)
+	result := batchResult{group: g, existsMap: existsMap, err: err}
+	if g.load && err == nil {
+		result.loadErr = ErrLoadNotSupported
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+package valet
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDBConcurrency_BoundsParallelQueries(t *testing.T) {
+	var running, peak atomic.Int32
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return map[any]bool{float64(1): true}, nil
+	})
+
+	data := DataObject{}
+	schema := Schema{}
+	for i := 0; i < 12; i++ {
+		field := fmt.Sprintf("f%d", i)
+		data[field] = float64(1)
+		schema[field] = Float().Exists(fmt.Sprintf("t%d", i), "id")
+	}
+
+	if verr := Validate(data, schema, Options{DBChecker: checker, DBConcurrency: 3}); verr != nil {
+		t.Fatalf("Unexpected error: %v", verr.Errors)
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("Peak concurrency = %d, want <= 3", p)
+	}
+}
+
+func TestDBQueryTimeout(t *testing.T) {
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("Expected a deadline on the query context")
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	data := DataObject{"id": float64(1)}
+	schema := Schema{"id": Float().Exists("users", "id")}
+
+	verr := Validate(data, schema, Options{DBChecker: checker, DBQueryTimeout: 10 * time.Millisecond})
+	if verr == nil || !errors.Is(verr, context.DeadlineExceeded) || !errors.Is(verr, ErrDBCheckFailed) {
+		t.Fatalf("Expected deadline exceeded DB error, got: %v", verr)
+	}
+}
+
+func TestDBRetry(t *testing.T) {
+	var attempts atomic.Int32
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		if attempts.Add(1) < 3 {
+			return nil, driver.ErrBadConn
+		}
+		return map[any]bool{float64(1): true}, nil
+	})
+
+	data := DataObject{"id": float64(1)}
+	schema := Schema{"id": Float().Exists("users", "id")}
+
+	verr := Validate(data, schema, Options{
+		DBChecker: checker,
+		DBRetry:   &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+	})
+	if verr != nil {
+		t.Fatalf("Expected success after retries, got: %v", verr.DBError)
+	}
+	if attempts.Load() != 3 {
+		t.Errorf("Attempts = %d, want 3", attempts.Load())
+	}
+
+	// Permanent errors are not retried
+	attempts.Store(0)
+	permanent := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		attempts.Add(1)
+		return nil, errors.New("syntax error")
+	})
+	verr = Validate(data, schema, Options{
+		DBChecker: permanent,
+		DBRetry:   &RetryPolicy{MaxAttempts: 3},
+	})
+	if verr == nil || verr.DBError == nil {
+		t.Fatal("Expected DB error")
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Attempts = %d, want 1", attempts.Load())
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	want := []time.Duration{10, 20, 40, 50, 50}
+	for i, w := range want {
+		if got := p.backoff(i + 1); got != w*time.Millisecond {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w*time.Millisecond)
+		}
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+func TestIsRetryableDBError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{driver.ErrBadConn, true},
+		{fmt.Errorf("query: %w", driver.ErrBadConn), true},
+		{context.DeadlineExceeded, true},
+		{timeoutError{}, true},
+		{context.Canceled, false},
+		{errors.New("duplicate key"), false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRetryableDBError(tt.err); got != tt.want {
+			t.Errorf("IsRetryableDBError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	var calls atomic.Int32
+	var fail atomic.Bool
+	fail.Store(true)
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		calls.Add(1)
+		if fail.Load() {
+			return nil, fmt.Errorf("connection refused: %w", driver.ErrBadConn)
+		}
+		return map[any]bool{float64(1): true}, nil
+	})
+
+	data := DataObject{"id": float64(1)}
+	schema := Schema{"id": Float().Exists("users", "id")}
+	opts := Options{DBChecker: checker, DBCircuitBreaker: breaker}
+
+	Validate(data, schema, opts)
+	Validate(data, schema, opts)
+	if breaker.State() != CircuitOpen {
+		t.Fatalf("State = %v, want open", breaker.State())
+	}
+
+	verr := Validate(data, schema, opts)
+	if verr == nil || !errors.Is(verr, ErrCircuitOpen) || !errors.Is(verr, ErrDBCheckFailed) {
+		t.Fatalf("Expected ErrCircuitOpen, got: %v", verr)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Open breaker should not query, got %d calls", calls.Load())
+	}
+
+	// After the cooldown a trial query closes the breaker again
+	now = now.Add(time.Minute)
+	if breaker.State() != CircuitHalfOpen {
+		t.Fatalf("State = %v, want half-open", breaker.State())
+	}
+	fail.Store(false)
+	if verr := Validate(data, schema, opts); verr != nil {
+		t.Fatalf("Expected trial query to succeed, got: %v", verr.DBError)
+	}
+	if breaker.State() != CircuitClosed {
+		t.Errorf("State = %v, want closed", breaker.State())
+	}
+}
+
+func TestCircuitBreaker_IgnoresNonTransientErrors(t *testing.T) {
+	breaker := NewCircuitBreaker(1, time.Minute)
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("syntax error")
+	})
+	opts := Options{DBChecker: checker, DBCircuitBreaker: breaker}
+	data := DataObject{"id": float64(1)}
+
+	Validate(data, Schema{"id": Float().Exists("users", "id")}, opts)
+	Validate(data, Schema{"id": Float().Exists("users;drop", "id")}, opts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ValidateWithDBContext(ctx, data, Schema{"id": Float().Exists("users", "id")}, opts)
+
+	if breaker.State() != CircuitClosed {
+		t.Errorf("State = %v, want closed", breaker.State())
+	}
+}
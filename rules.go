@@ -0,0 +1,1001 @@
+package valet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RuleError reports an invalid rule in a Laravel-style rule string
+type RuleError struct {
+	Rules  string // The full rule string
+	Rule   string // The offending rule, e.g. "max:abc"
+	Offset int    // Byte offset of Rule in Rules
+	Reason string
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("valet: rule %q at offset %d: %s", e.Rule, e.Offset, e.Reason)
+}
+
+// Rules builds a validator from a Laravel-style rule string such as
+// "required|string|max:255|email|unique:users,email". The type rule (string,
+// integer, numeric, boolean, array, file, image, date) selects String(),
+// Int(), Float(), Bool(), Array(), File() or Time(); without one the type is
+// inferred from the other rules and defaults to String(). As in Laravel,
+// min/max/size/between compare length for strings, value for numbers, count
+// for arrays and kilobytes for files. Unknown rules, rules that do not apply
+// to the type and malformed parameters are reported as *RuleError.
+func Rules(rules string) (Validator, error) {
+	tokens, err := tokenizeRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	b := &ruleBuilder{rules: rules, tokens: tokens}
+	return b.build()
+}
+
+// MustRules is like Rules but panics on an invalid rule string, for schema
+// literals
+func MustRules(rules string) Validator {
+	v, err := Rules(rules)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ruleToken is a single rule and its comma-separated parameters
+type ruleToken struct {
+	name   string
+	params []string
+	raw    string
+	offset int
+}
+
+// tokenizeRules splits a rule string on "|". Delimited regex patterns
+// (regex:/a|b/i) may contain "|".
+func tokenizeRules(rules string) ([]ruleToken, error) {
+	var tokens []ruleToken
+	for offset := 0; offset <= len(rules); {
+		end := strings.IndexByte(rules[offset:], '|')
+		if end < 0 {
+			end = len(rules)
+		} else {
+			end += offset
+		}
+
+		name, param, hasParam := strings.Cut(rules[offset:end], ":")
+		if (name == "regex" || name == "not_regex") && hasParam {
+			start := offset + len(name) + 1
+			closing, err := regexRuleEnd(rules, start)
+			if err != nil {
+				return nil, &RuleError{Rules: rules, Rule: rules[offset:end], Offset: offset, Reason: err.Error()}
+			}
+			end = closing
+			param = rules[start:end]
+		}
+
+		tok := ruleToken{name: strings.TrimSpace(name), raw: rules[offset:end], offset: offset}
+		if tok.name == "" {
+			return nil, &RuleError{Rules: rules, Rule: tok.raw, Offset: offset, Reason: "empty rule"}
+		}
+		if hasParam {
+			if tok.name == "regex" || tok.name == "not_regex" {
+				tok.params = []string{param}
+			} else {
+				tok.params = strings.Split(param, ",")
+			}
+		}
+		tokens = append(tokens, tok)
+
+		if end < len(rules) && rules[end] != '|' {
+			return nil, &RuleError{Rules: rules, Rule: rules[offset:], Offset: offset, Reason: "expected | after the regex flags"}
+		}
+		offset = end + 1
+	}
+	return tokens, nil
+}
+
+// regexRuleEnd returns the end of a delimited pattern starting at start,
+// including its flags
+func regexRuleEnd(rules string, start int) (int, error) {
+	if start >= len(rules) {
+		return 0, fmt.Errorf("missing pattern")
+	}
+	delim := rules[start]
+	if isRegexFlag(delim) || delim == '\\' || delim == ' ' {
+		return 0, fmt.Errorf("pattern must be delimited, e.g. /^[a-z]+$/")
+	}
+	for i := start + 1; i < len(rules); i++ {
+		switch rules[i] {
+		case '\\':
+			i++
+		case delim:
+			end := i + 1
+			for end < len(rules) && isRegexFlag(rules[end]) {
+				end++
+			}
+			return end, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated pattern")
+}
+
+func isRegexFlag(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// goRegex converts a delimited PHP pattern ("/^[a-z]+$/i") to Go syntax
+func goRegex(param string) (string, error) {
+	delim := param[0]
+	closing := strings.LastIndexByte(param, delim)
+	pattern, flags := param[1:closing], param[closing+1:]
+	var prefix string
+	for _, f := range flags {
+		switch f {
+		case 'i', 'm', 's':
+			prefix += string(f)
+		case 'u': // Go patterns are always UTF-8
+		default:
+			return "", fmt.Errorf("unsupported regex flag %q", f)
+		}
+	}
+	if prefix != "" {
+		pattern = "(?" + prefix + ")" + pattern
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return "", err
+	}
+	return pattern, nil
+}
+
+// ruleTypes maps type rules to validator types
+var ruleTypes = map[string]string{
+	"string":   "string",
+	"integer":  "integer",
+	"numeric":  "number",
+	"boolean":  "boolean",
+	"bool":     "boolean",
+	"accepted": "boolean",
+	"declined": "boolean",
+	"array":    "array",
+	"file":     "file",
+	"image":    "file",
+	"date":     "time",
+}
+
+// inferredRuleTypes are type-specific rules that select a type when the rule
+// string has no type rule
+var inferredRuleTypes = map[string]string{
+	"mimes":          "file",
+	"mimetypes":      "file",
+	"extensions":     "file",
+	"dimensions":     "file",
+	"date_format":    "time",
+	"after":          "time",
+	"after_or_equal": "time",
+	"before":         "time",
+	"distinct":       "array",
+}
+
+// ruleBuilder builds a validator from parsed rules
+type ruleBuilder struct {
+	rules          string
+	tokens         []ruleToken
+	typ            string
+	required       bool
+	nullable       bool
+	requiredIf     []func(DataObject) bool
+	requiredUnless func(DataObject) bool
+}
+
+func (b *ruleBuilder) errorf(tok ruleToken, format string, args ...any) error {
+	return &RuleError{Rules: b.rules, Rule: tok.raw, Offset: tok.offset, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (b *ruleBuilder) build() (Validator, error) {
+	var rest []ruleToken
+	for _, tok := range b.tokens {
+		handled, err := b.common(tok)
+		if err != nil {
+			return nil, err
+		}
+		if !handled {
+			rest = append(rest, tok)
+		}
+	}
+	if b.typ == "" {
+		b.typ = "string"
+		for _, tok := range rest {
+			if typ, ok := inferredRuleTypes[tok.name]; ok {
+				b.typ = typ
+				break
+			}
+		}
+	}
+
+	switch b.typ {
+	case "string":
+		return b.buildString(rest)
+	case "integer":
+		return buildNumberRules(b, Int(), rest)
+	case "number":
+		return buildNumberRules(b, Float(), rest)
+	case "boolean":
+		return b.buildBool(rest)
+	case "array":
+		return b.buildArray(rest)
+	case "file":
+		return b.buildFile(rest)
+	}
+	return b.buildTime(rest)
+}
+
+// common handles the type and presence rules shared by all types
+func (b *ruleBuilder) common(tok ruleToken) (bool, error) {
+	if typ, ok := ruleTypes[tok.name]; ok {
+		if b.typ != "" && b.typ != typ {
+			return false, b.errorf(tok, "conflicts with the %s type", b.typ)
+		}
+		b.typ = typ
+		// Rules with behavior beyond the type are applied by the type builder
+		return tok.name != "image" && tok.name != "accepted" && tok.name != "declined", nil
+	}
+
+	switch tok.name {
+	case "required":
+		b.required = true
+	case "nullable":
+		b.nullable = true
+	case "sometimes", "bail": // Absent fields are skipped and rules stop at type errors by default
+	case "required_if", "required_unless":
+		if len(tok.params) < 2 {
+			return false, b.errorf(tok, "expects a field and at least one value")
+		}
+		field, values := tok.params[0], tok.params[1:]
+		matches := func(data DataObject) bool {
+			actual := lookupPath(data, field).Value()
+			for _, want := range values {
+				if ruleValueMatches(actual, want) {
+					return true
+				}
+			}
+			return false
+		}
+		if tok.name == "required_if" {
+			b.requiredIf = append(b.requiredIf, matches)
+		} else {
+			b.requiredUnless = matches
+		}
+	case "required_with", "required_with_all", "required_without", "required_without_all":
+		if len(tok.params) == 0 {
+			return false, b.errorf(tok, "expects at least one field")
+		}
+		fields := tok.params
+		with := strings.HasPrefix(tok.name, "required_with_") || tok.name == "required_with"
+		all := strings.HasSuffix(tok.name, "_all")
+		b.requiredIf = append(b.requiredIf, func(data DataObject) bool {
+			count := 0
+			for _, f := range fields {
+				if filled(lookupPath(data, f).Value()) == with {
+					count++
+				}
+			}
+			if all {
+				return count == len(fields)
+			}
+			return count > 0
+		})
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// requiredIfFunc combines the required_if and required_with conditions
+func (b *ruleBuilder) requiredIfFunc() func(DataObject) bool {
+	if len(b.requiredIf) == 0 {
+		return nil
+	}
+	conditions := b.requiredIf
+	return func(data DataObject) bool {
+		for _, c := range conditions {
+			if c(data) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ruleValueMatches compares a payload value with a rule parameter the way
+// Laravel's required_if does ("null", "true" and "false" are keywords)
+func ruleValueMatches(actual any, want string) bool {
+	switch want {
+	case "null":
+		return actual == nil
+	case "true", "false":
+		if b, ok := actual.(bool); ok {
+			return strconv.FormatBool(b) == want
+		}
+	}
+	if actual == nil {
+		return false
+	}
+	return fmt.Sprint(actual) == want
+}
+
+// filled reports whether a payload value is present and not empty
+func filled(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case string:
+		return strings.TrimSpace(t) != ""
+	case []any:
+		return len(t) > 0
+	}
+	return true
+}
+
+func (b *ruleBuilder) int(tok ruleToken, i int) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(tok.params[i]))
+	if err != nil {
+		return 0, b.errorf(tok, "parameter %q is not an integer", tok.params[i])
+	}
+	return n, nil
+}
+
+func (b *ruleBuilder) float(tok ruleToken, i int) (float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(tok.params[i]), 64)
+	if err != nil {
+		return 0, b.errorf(tok, "parameter %q is not a number", tok.params[i])
+	}
+	return f, nil
+}
+
+// params checks the parameter count; max < 0 means unlimited
+func (b *ruleBuilder) params(tok ruleToken, min, max int) error {
+	n := len(tok.params)
+	switch {
+	case n < min && min == max:
+		return b.errorf(tok, "expects %d parameter(s)", min)
+	case n < min:
+		return b.errorf(tok, "expects at least %d parameter(s)", min)
+	case max >= 0 && n > max:
+		return b.errorf(tok, "expects at most %d parameter(s)", max)
+	}
+	return nil
+}
+
+// ints parses count parameters (min, max, size, between, digits)
+func (b *ruleBuilder) ints(tok ruleToken, n int) ([]int, error) {
+	if err := b.params(tok, n, n); err != nil {
+		return nil, err
+	}
+	out := make([]int, n)
+	for i := range out {
+		v, err := b.int(tok, i)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// dbRule parses exists:table,column and unique:table,column[,except[,idColumn]]
+func (b *ruleBuilder) dbRule(tok ruleToken) (table, column, exceptColumn string, except any, err error) {
+	max := 2
+	if tok.name == "unique" {
+		max = 4
+	}
+	if len(tok.params) < 2 {
+		return "", "", "", nil, b.errorf(tok, "expects a table and a column, e.g. %s:users,email", tok.name)
+	}
+	if err := b.params(tok, 2, max); err != nil {
+		return "", "", "", nil, err
+	}
+	table, column = strings.TrimSpace(tok.params[0]), strings.TrimSpace(tok.params[1])
+	for _, name := range []string{table, column} {
+		if err := validateIdentifier(name); err != nil {
+			return "", "", "", nil, b.errorf(tok, "%v", err)
+		}
+	}
+	if len(tok.params) > 2 && !strings.EqualFold(tok.params[2], "null") {
+		exceptColumn = "id"
+		if len(tok.params) > 3 {
+			exceptColumn = strings.TrimSpace(tok.params[3])
+			if err := validateIdentifier(exceptColumn); err != nil {
+				return "", "", "", nil, b.errorf(tok, "%v", err)
+			}
+		}
+		except = tok.params[2]
+	}
+	return table, column, exceptColumn, except, nil
+}
+
+func (b *ruleBuilder) notApplicable(tok ruleToken) error {
+	if _, known := knownRules[tok.name]; known {
+		return b.errorf(tok, "does not apply to %s fields", b.typ)
+	}
+	return b.errorf(tok, "unknown rule")
+}
+
+// knownRules are the supported rule names, for error messages
+var knownRules = map[string]bool{
+	"email": true, "url": true, "uuid": true, "ulid": true, "ip": true, "ipv4": true, "ipv6": true,
+	"mac_address": true, "json": true, "alpha": true, "alpha_num": true, "alpha_dash": true,
+	"ascii": true, "hex_color": true, "min": true, "max": true, "size": true, "between": true,
+	"in": true, "not_in": true, "regex": true, "not_regex": true, "starts_with": true,
+	"ends_with": true, "doesnt_start_with": true, "doesnt_end_with": true, "digits": true,
+	"digits_between": true, "same": true, "different": true, "exists": true, "unique": true,
+	"gt": true, "gte": true, "lt": true, "lte": true, "multiple_of": true, "distinct": true,
+	"mimes": true, "mimetypes": true, "extensions": true, "dimensions": true, "image": true,
+	"accepted": true, "declined": true, "date_format": true, "after": true,
+	"after_or_equal": true, "before": true,
+}
+
+// stringRuleFlags maps flag rules to their StringValidator methods
+var stringRuleFlags = map[string]func(*StringValidator){
+	"email":       func(v *StringValidator) { v.Email() },
+	"url":         func(v *StringValidator) { v.URL() },
+	"uuid":        func(v *StringValidator) { v.UUID() },
+	"ulid":        func(v *StringValidator) { v.ULID() },
+	"ip":          func(v *StringValidator) { v.IP() },
+	"ipv4":        func(v *StringValidator) { v.IPv4() },
+	"ipv6":        func(v *StringValidator) { v.IPv6() },
+	"mac_address": func(v *StringValidator) { v.MAC() },
+	"json":        func(v *StringValidator) { v.JSON() },
+	"alpha":       func(v *StringValidator) { v.Alpha() },
+	"alpha_num":   func(v *StringValidator) { v.AlphaNumeric() },
+	"alpha_dash":  func(v *StringValidator) { v.AlphaDash() },
+	"ascii":       func(v *StringValidator) { v.ASCII() },
+	"hex_color":   func(v *StringValidator) { v.HexColor() },
+}
+
+func (b *ruleBuilder) buildString(tokens []ruleToken) (Validator, error) {
+	v := String()
+	if b.required {
+		v.Required()
+	}
+	if b.nullable {
+		v.Nullable()
+	}
+	if fn := b.requiredIfFunc(); fn != nil {
+		v.RequiredIf(fn)
+	}
+	if b.requiredUnless != nil {
+		v.RequiredUnless(b.requiredUnless)
+	}
+
+	for _, tok := range tokens {
+		if apply, ok := stringRuleFlags[tok.name]; ok {
+			if err := b.params(tok, 0, 0); err != nil {
+				return nil, err
+			}
+			apply(v)
+			continue
+		}
+		switch tok.name {
+		case "min", "max", "size", "digits":
+			n, err := b.ints(tok, 1)
+			if err != nil {
+				return nil, err
+			}
+			switch tok.name {
+			case "min":
+				v.Min(n[0])
+			case "max":
+				v.Max(n[0])
+			case "size":
+				v.Length(n[0])
+			default:
+				v.Digits(n[0])
+			}
+		case "between":
+			n, err := b.ints(tok, 2)
+			if err != nil {
+				return nil, err
+			}
+			v.Min(n[0]).Max(n[1])
+		case "in", "not_in", "doesnt_start_with", "doesnt_end_with":
+			if err := b.params(tok, 1, -1); err != nil {
+				return nil, err
+			}
+			switch tok.name {
+			case "in":
+				v.In(tok.params...)
+			case "not_in":
+				v.NotIn(tok.params...)
+			case "doesnt_start_with":
+				v.DoesntStartWith(tok.params...)
+			default:
+				v.DoesntEndWith(tok.params...)
+			}
+		case "starts_with", "ends_with", "same", "different":
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			switch tok.name {
+			case "starts_with":
+				v.StartsWith(tok.params[0])
+			case "ends_with":
+				v.EndsWith(tok.params[0])
+			case "same":
+				v.SameAs(tok.params[0])
+			default:
+				v.DifferentFrom(tok.params[0])
+			}
+		case "regex", "not_regex":
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			pattern, err := goRegex(tok.params[0])
+			if err != nil {
+				return nil, b.errorf(tok, "%v", err)
+			}
+			if tok.name == "regex" {
+				v.Regex(pattern)
+			} else {
+				v.NotRegex(pattern)
+			}
+		case "exists", "unique":
+			table, column, exceptColumn, except, err := b.dbRule(tok)
+			if err != nil {
+				return nil, err
+			}
+			if tok.name == "exists" {
+				v.Exists(table, column)
+			} else {
+				v.Unique(table, column, nil)
+				if exceptColumn != "" {
+					v.IgnoreWhere(exceptColumn, except)
+				}
+			}
+		default:
+			return nil, b.notApplicable(tok)
+		}
+	}
+	return v, nil
+}
+
+func buildNumberRules[T Number](b *ruleBuilder, v *NumberValidator[T], tokens []ruleToken) (Validator, error) {
+	if b.required {
+		v.Required()
+	}
+	if b.nullable {
+		v.Nullable()
+	}
+	if fn := b.requiredIfFunc(); fn != nil {
+		v.RequiredIf(fn)
+	}
+	if b.requiredUnless != nil {
+		v.RequiredUnless(b.requiredUnless)
+	}
+
+	number := func(tok ruleToken, i int) (T, error) {
+		f, err := b.float(tok, i)
+		return T(f), err
+	}
+	for _, tok := range tokens {
+		switch tok.name {
+		case "min", "max", "size", "multiple_of":
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			n, err := number(tok, 0)
+			if err != nil {
+				return nil, err
+			}
+			switch tok.name {
+			case "min":
+				v.Min(n)
+			case "max":
+				v.Max(n)
+			case "size":
+				v.Between(n, n)
+			default:
+				v.MultipleOf(n)
+			}
+		case "between":
+			if err := b.params(tok, 2, 2); err != nil {
+				return nil, err
+			}
+			min, err := number(tok, 0)
+			if err != nil {
+				return nil, err
+			}
+			max, err := number(tok, 1)
+			if err != nil {
+				return nil, err
+			}
+			v.Between(min, max)
+		case "digits", "digits_between":
+			count := 1
+			if tok.name == "digits_between" {
+				count = 2
+			}
+			n, err := b.ints(tok, count)
+			if err != nil {
+				return nil, err
+			}
+			v.MinDigits(n[0]).MaxDigits(n[len(n)-1])
+		case "in", "not_in":
+			if err := b.params(tok, 1, -1); err != nil {
+				return nil, err
+			}
+			values := make([]T, len(tok.params))
+			for i := range tok.params {
+				n, err := number(tok, i)
+				if err != nil {
+					return nil, err
+				}
+				values[i] = n
+			}
+			if tok.name == "in" {
+				v.In(values...)
+			} else {
+				v.NotIn(values...)
+			}
+		case "gt", "gte", "lt", "lte":
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			param := tok.params[0]
+			if _, err := strconv.ParseFloat(param, 64); err == nil {
+				// Laravel also compares with a literal value; only the
+				// inclusive forms have a valet equivalent
+				n, _ := number(tok, 0)
+				switch tok.name {
+				case "gte":
+					v.Min(n)
+				case "lte":
+					v.Max(n)
+				default:
+					// min and max are inclusive, so suggesting them as-is
+					// would quietly turn > into >=
+					if tok.name == "gt" {
+						return nil, b.errorf(tok, "compares with a field; valet has no exclusive value bound, use min (inclusive, >= %s) for a value", param)
+					}
+					return nil, b.errorf(tok, "compares with a field; valet has no exclusive value bound, use max (inclusive, <= %s) for a value", param)
+				}
+				continue
+			}
+			switch tok.name {
+			case "gt":
+				v.GreaterThan(param)
+			case "gte":
+				v.GreaterThanOrEqual(param)
+			case "lt":
+				v.LessThan(param)
+			default:
+				v.LessThanOrEqual(param)
+			}
+		case "regex", "not_regex":
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			pattern, err := goRegex(tok.params[0])
+			if err != nil {
+				return nil, b.errorf(tok, "%v", err)
+			}
+			if tok.name == "regex" {
+				v.Regex(pattern)
+			} else {
+				v.NotRegex(pattern)
+			}
+		case "exists", "unique":
+			table, column, exceptColumn, except, err := b.dbRule(tok)
+			if err != nil {
+				return nil, err
+			}
+			if tok.name == "exists" {
+				v.Exists(table, column)
+			} else {
+				v.Unique(table, column, nil)
+				if exceptColumn != "" {
+					v.IgnoreWhere(exceptColumn, except)
+				}
+			}
+		default:
+			return nil, b.notApplicable(tok)
+		}
+	}
+	return v, nil
+}
+
+func (b *ruleBuilder) buildBool(tokens []ruleToken) (Validator, error) {
+	// Laravel accepts true, false, 1, 0, "1" and "0"
+	v := Bool().Coerce()
+	if b.required {
+		v.Required()
+	}
+	if b.nullable {
+		v.Nullable()
+	}
+	if fn := b.requiredIfFunc(); fn != nil {
+		v.RequiredIf(fn)
+	}
+	if b.requiredUnless != nil {
+		v.RequiredUnless(b.requiredUnless)
+	}
+	for _, tok := range tokens {
+		switch tok.name {
+		case "accepted":
+			v.True()
+		case "declined":
+			v.False()
+		default:
+			return nil, b.notApplicable(tok)
+		}
+	}
+	return v, nil
+}
+
+func (b *ruleBuilder) buildArray(tokens []ruleToken) (Validator, error) {
+	v := Array()
+	if b.required {
+		v.Required()
+	}
+	if b.nullable {
+		v.Nullable()
+	}
+	if fn := b.requiredIfFunc(); fn != nil {
+		v.RequiredIf(fn)
+	}
+	if b.requiredUnless != nil {
+		v.RequiredUnless(b.requiredUnless)
+	}
+	for _, tok := range tokens {
+		switch tok.name {
+		case "min", "max", "size":
+			n, err := b.ints(tok, 1)
+			if err != nil {
+				return nil, err
+			}
+			switch tok.name {
+			case "min":
+				v.Min(n[0])
+			case "max":
+				v.Max(n[0])
+			default:
+				v.Length(n[0])
+			}
+		case "between":
+			n, err := b.ints(tok, 2)
+			if err != nil {
+				return nil, err
+			}
+			v.Min(n[0]).Max(n[1])
+		case "distinct":
+			v.Unique()
+		case "exists":
+			table, column, _, _, err := b.dbRule(tok)
+			if err != nil {
+				return nil, err
+			}
+			v.Exists(table, column)
+		default:
+			return nil, b.notApplicable(tok)
+		}
+	}
+	return v, nil
+}
+
+func (b *ruleBuilder) buildFile(tokens []ruleToken) (Validator, error) {
+	v := File()
+	if b.required {
+		v.Required()
+	}
+	if b.nullable {
+		v.Nullable()
+	}
+	if fn := b.requiredIfFunc(); fn != nil {
+		v.RequiredIf(fn)
+	}
+	if b.requiredUnless != nil {
+		v.RequiredUnless(b.requiredUnless)
+	}
+	for _, tok := range tokens {
+		switch tok.name {
+		case "image":
+			v.Image()
+		case "min", "max", "size":
+			// Laravel file sizes are in kilobytes
+			n, err := b.ints(tok, 1)
+			if err != nil {
+				return nil, err
+			}
+			bytes := int64(n[0]) * 1024
+			if tok.name != "max" {
+				v.Min(bytes)
+			}
+			if tok.name != "min" {
+				v.Max(bytes)
+			}
+		case "between":
+			n, err := b.ints(tok, 2)
+			if err != nil {
+				return nil, err
+			}
+			v.Min(int64(n[0]) * 1024).Max(int64(n[1]) * 1024)
+		case "mimes", "extensions":
+			// Laravel's mimes rule lists extensions; mimetypes lists MIME types
+			if err := b.params(tok, 1, -1); err != nil {
+				return nil, err
+			}
+			v.Extensions(tok.params...)
+		case "mimetypes":
+			if err := b.params(tok, 1, -1); err != nil {
+				return nil, err
+			}
+			v.Mimes(tok.params...)
+		case "dimensions":
+			d, err := b.dimensions(tok)
+			if err != nil {
+				return nil, err
+			}
+			v.Dimensions(d)
+		default:
+			return nil, b.notApplicable(tok)
+		}
+	}
+	return v, nil
+}
+
+// dimensions parses dimensions:min_width=100,ratio=3/2
+func (b *ruleBuilder) dimensions(tok ruleToken) (*ImageDimensions, error) {
+	if err := b.params(tok, 1, -1); err != nil {
+		return nil, err
+	}
+	d := &ImageDimensions{}
+	fields := map[string]*int{
+		"width": &d.Width, "height": &d.Height,
+		"min_width": &d.MinWidth, "max_width": &d.MaxWidth,
+		"min_height": &d.MinHeight, "max_height": &d.MaxHeight,
+	}
+	for _, p := range tok.params {
+		key, value, ok := strings.Cut(strings.TrimSpace(p), "=")
+		if !ok {
+			return nil, b.errorf(tok, "parameter %q must be key=value", p)
+		}
+		if key == "ratio" {
+			d.Ratio = value
+			continue
+		}
+		field, ok := fields[key]
+		if !ok {
+			return nil, b.errorf(tok, "unknown dimension %q", key)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, b.errorf(tok, "dimension %q is not an integer", key)
+		}
+		*field = n
+	}
+	return d, nil
+}
+
+func (b *ruleBuilder) buildTime(tokens []ruleToken) (Validator, error) {
+	v := Time().Format(time.DateOnly)
+	if b.required {
+		v.Required()
+	}
+	if b.nullable {
+		v.Nullable()
+	}
+	if fn := b.requiredIfFunc(); fn != nil {
+		v.RequiredIf(fn)
+	}
+	if b.requiredUnless != nil {
+		v.RequiredUnless(b.requiredUnless)
+	}
+
+	// date_format applies to the dates of after/before, wherever it appears
+	for _, tok := range tokens {
+		if tok.name == "date_format" {
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			layout, err := goTimeLayout(tok.params[0])
+			if err != nil {
+				return nil, b.errorf(tok, "%v", err)
+			}
+			v.Format(layout)
+		}
+	}
+
+	for _, tok := range tokens {
+		switch tok.name {
+		case "date_format":
+		case "after", "after_or_equal", "before":
+			if err := b.params(tok, 1, 1); err != nil {
+				return nil, err
+			}
+			t, isDate, err := b.ruleDate(tok, v.format)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case !isDate && tok.name == "after":
+				v.AfterField(tok.params[0])
+			case !isDate && tok.name == "before":
+				v.BeforeField(tok.params[0])
+			case !isDate:
+				return nil, b.errorf(tok, "only compares with a date, not a field")
+			case tok.name == "after_or_equal":
+				v.After(t.Add(-time.Nanosecond))
+			case tok.name == "after":
+				v.After(t)
+			default:
+				v.Before(t)
+			}
+		default:
+			return nil, b.notApplicable(tok)
+		}
+	}
+	return v, nil
+}
+
+// ruleDate parses the date parameter of after/before: a date in the field's
+// format or RFC 3339, "now", "today", "tomorrow" or "yesterday". Other
+// values are field paths.
+func (b *ruleBuilder) ruleDate(tok ruleToken, layout string) (time.Time, bool, error) {
+	param := strings.TrimSpace(tok.params[0])
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch param {
+	case "now":
+		return now, true, nil
+	case "today":
+		return today, true, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true, nil
+	}
+	for _, l := range []string{layout, time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(l, param); err == nil {
+			return t, true, nil
+		}
+	}
+	if identifierRegex.MatchString(param) {
+		return time.Time{}, false, nil
+	}
+	return time.Time{}, false, b.errorf(tok, "%q is neither a date nor a field", param)
+}
+
+// phpTimeTokens maps PHP date format characters to Go layout elements
+var phpTimeTokens = map[rune]string{
+	'Y': "2006", 'y': "06", 'm': "01", 'n': "1", 'd': "02", 'j': "2",
+	'M': "Jan", 'F': "January", 'D': "Mon", 'l': "Monday",
+	'H': "15", 'G': "15", 'h': "03", 'g': "3", 'i': "04", 's': "05",
+	'A': "PM", 'a': "pm", 'v': ".000", 'u': ".000000",
+	'T': "MST", 'P': "-07:00", 'O': "-0700", 'c': time.RFC3339,
+}
+
+// goTimeLayout converts a PHP date format ("Y-m-d H:i") to a Go layout
+func goTimeLayout(format string) (string, error) {
+	var sb strings.Builder
+	escaped := false
+	for _, r := range format {
+		switch {
+		case escaped:
+			sb.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case phpTimeTokens[r] != "":
+			sb.WriteString(phpTimeTokens[r])
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+			return "", fmt.Errorf("unsupported date format character %q", r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String(), nil
+}
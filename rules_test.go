@@ -0,0 +1,186 @@
+package valet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustRules(t *testing.T, rules string) Validator {
+	t.Helper()
+	v, err := Rules(rules)
+	if err != nil {
+		t.Fatalf("Rules(%q) error: %v", rules, err)
+	}
+	return v
+}
+
+func TestRules_Types(t *testing.T) {
+	cases := map[string]string{
+		"required|string|max:255":          "string",
+		"email":                            "string",
+		"integer|min:1":                    "integer",
+		"numeric|between:0,9.5":            "number",
+		"boolean":                          "boolean",
+		"accepted":                         "boolean",
+		"array|min:1|distinct":             "array",
+		"distinct":                         "array",
+		"image|max:2048":                   "file",
+		"mimes:pdf,docx":                   "file",
+		"date|after:today":                 "time",
+		"date_format:Y-m-d H:i|before:now": "time",
+	}
+	for rules, want := range cases {
+		d := mustRules(t, rules).(Describer).Describe()
+		if d.Type != want {
+			t.Errorf("%q: expected type %s, got %s", rules, want, d.Type)
+		}
+	}
+}
+
+func TestRules_String(t *testing.T) {
+	schema := Schema{
+		"email":    mustRules(t, "required|string|max:20|email"),
+		"username": mustRules(t, `required|alpha_dash|between:3,10|regex:/^[a-z|_-]+$/i|not_in:admin,root`),
+		"code":     mustRules(t, "nullable|starts_with:AB|size:4"),
+		"confirm":  mustRules(t, "same:email"),
+	}
+
+	valid := DataObject{"email": "a@example.com", "username": "Ann_B", "code": nil, "confirm": "a@example.com"}
+	if err := Validate(valid, schema); err != nil {
+		t.Errorf("Expected valid, got %v", err.Errors)
+	}
+
+	invalid := DataObject{"email": "not-an-email-at-all-really", "username": "root", "code": "XY12", "confirm": "b"}
+	err := Validate(invalid, schema)
+	if err == nil {
+		t.Fatal("Expected errors")
+	}
+	if len(err.Errors["email"]) != 2 {
+		t.Errorf("Expected max and email errors, got %v", err.Errors["email"])
+	}
+	for _, field := range []string{"username", "code", "confirm"} {
+		if len(err.Errors[field]) == 0 {
+			t.Errorf("Expected error for %s, got %v", field, err.Errors)
+		}
+	}
+}
+
+func TestRules_Numbers(t *testing.T) {
+	schema := Schema{
+		"age":   mustRules(t, "required|integer|min:18|max:120"),
+		"price": mustRules(t, "numeric|gte:0|multiple_of:0.5"),
+		"pin":   mustRules(t, "integer|digits:4"),
+		"max":   mustRules(t, "integer|gt:age"),
+	}
+	if err := Validate(DataObject{"age": float64(30), "price": 2.5, "pin": float64(1234), "max": float64(31)}, schema); err != nil {
+		t.Errorf("Expected valid, got %v", err.Errors)
+	}
+	err := Validate(DataObject{"age": 17.5, "price": -0.3, "pin": float64(12), "max": float64(10)}, schema)
+	if err == nil {
+		t.Fatal("Expected errors")
+	}
+	for _, field := range []string{"age", "price", "pin", "max"} {
+		if len(err.Errors[field]) == 0 {
+			t.Errorf("Expected error for %s, got %v", field, err.Errors)
+		}
+	}
+}
+
+func TestRules_Other(t *testing.T) {
+	schema := Schema{
+		"terms": mustRules(t, "accepted"),
+		"tags":  mustRules(t, "array|max:2|distinct"),
+		"start": mustRules(t, "date_format:d/m/Y|after:01/01/2024"),
+	}
+	if err := Validate(DataObject{"terms": "1", "tags": []any{"a", "b"}, "start": "02/01/2024"}, schema); err != nil {
+		t.Errorf("Expected valid, got %v", err.Errors)
+	}
+	err := Validate(DataObject{"terms": "0", "tags": []any{"a", "a", "b"}, "start": "01/01/2024"}, schema)
+	if err == nil {
+		t.Fatal("Expected errors")
+	}
+	for _, field := range []string{"terms", "tags", "start"} {
+		if len(err.Errors[field]) == 0 {
+			t.Errorf("Expected error for %s, got %v", field, err.Errors)
+		}
+	}
+}
+
+func TestRules_FileAndDB(t *testing.T) {
+	d := mustRules(t, "file|mimes:jpg,png|max:1024|dimensions:min_width=100,ratio=3/2").(Describer).Describe()
+	if r, ok := d.Rule("max"); !ok || r.Param != int64(1024*1024) {
+		t.Errorf("Expected max of 1 MiB, got %+v", d.Rules)
+	}
+	if _, ok := d.Rule("extensions"); !ok {
+		t.Errorf("Expected extensions rule, got %+v", d.Rules)
+	}
+
+	d = mustRules(t, "required|email|unique:users,email,5,user_id").(Describer).Describe()
+	if len(d.DB) != 1 || d.DB[0].Rule != "unique" || d.DB[0].Table != "users" || len(d.DB[0].Where) != 1 || d.DB[0].Where[0].Column != "user_id" {
+		t.Errorf("Unexpected DB rules: %+v", d.DB)
+	}
+	d = mustRules(t, "integer|exists:countries,id").(Describer).Describe()
+	if len(d.DB) != 1 || d.DB[0].Rule != "exists" || d.DB[0].Column != "id" {
+		t.Errorf("Unexpected DB rules: %+v", d.DB)
+	}
+}
+
+func TestRules_RequiredIf(t *testing.T) {
+	schema := Schema{
+		"type":    String(),
+		"company": mustRules(t, "required_if:type,business,partner|string"),
+		"phone":   mustRules(t, "required_without:email"),
+		"email":   mustRules(t, "email"),
+	}
+	err := Validate(DataObject{"type": "business"}, schema)
+	if err == nil || len(err.Errors["company"]) == 0 || len(err.Errors["phone"]) == 0 {
+		t.Errorf("Expected company and phone errors, got %v", err)
+	}
+	if err := Validate(DataObject{"type": "person", "email": "a@example.com"}, schema); err != nil {
+		t.Errorf("Expected valid, got %v", err.Errors)
+	}
+}
+
+func TestRules_Errors(t *testing.T) {
+	cases := map[string]string{
+		"required|max:abc":          "max:abc",
+		"string|integer":            "integer",
+		"required||max:3":           "",
+		"integer|email":             "email",
+		"shiny":                     "shiny",
+		"unique:users":              "unique:users",
+		"exists:users;drop,id":      "exists:users;drop,id",
+		"regex:/[a-z]":              "regex:/[a-z]",
+		"regex:/a/x":                "regex:/a/x",
+		"between:1":                 "between:1",
+		"required_if:type":          "required_if:type",
+		"image|dimensions:depth=3":  "dimensions:depth=3",
+		"date_format:Y-m-d Q":       "date_format:Y-m-d Q",
+		"date|after_or_equal:start": "after_or_equal:start",
+		"integer|gt:5":              "gt:5",
+		"string|regex":              "regex",
+		"integer|not_regex":         "not_regex",
+	}
+	for rules, rule := range cases {
+		_, err := Rules(rules)
+		var rerr *RuleError
+		if !errors.As(err, &rerr) {
+			t.Errorf("%q: expected RuleError, got %v", rules, err)
+			continue
+		}
+		if rerr.Rule != rule || rerr.Offset != strings.Index(rules, rule) && rule != "" {
+			t.Errorf("%q: expected rule %q, got %q at %d", rules, rule, rerr.Rule, rerr.Offset)
+		}
+	}
+
+	_, err := Rules("integer|gt:5")
+	if err == nil || !strings.Contains(err.Error(), "use min (inclusive, >= 5)") {
+		t.Errorf("Expected gt to point at the inclusive min, got %v", err)
+	}
+
+	_, err = Rules("required|max:abc")
+	if err == nil || err.Error() != `valet: rule "max:abc" at offset 9: parameter "abc" is not an integer` {
+		t.Errorf("Unexpected message: %v", err)
+	}
+}
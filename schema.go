@@ -24,11 +24,13 @@ func Optional(validator Validator) *OptionalValidator {
 func (v *OptionalValidator) Validate(ctx *ValidationContext, value any) map[string][]string {
 	// If value is nil or empty, it's valid (optional field)
 	if value == nil {
+		ctx.skip(skipOptional)
 		return nil
 	}
 
 	// For strings, empty is also valid
 	if str, ok := value.(string); ok && str == "" {
+		ctx.skip(skipOptional)
 		return nil
 	}
 
@@ -44,6 +46,22 @@ func (v *OptionalValidator) GetDBChecks(fieldPath string, value any) []DBCheck {
 	return nil
 }
 
+// describeRules lists the inner validator's rules for Explain
+func (v *OptionalValidator) describeRules() []ruleDesc {
+	if d, ok := v.inner.(ruleDescriber); ok {
+		return d.describeRules()
+	}
+	return nil
+}
+
+// Describe returns the inner validator's description marked Optional
+func (v *OptionalValidator) Describe() Description {
+	d := describeValidator(v.inner)
+	d.Optional = true
+	d.Required = false
+	return d
+}
+
 // ============================================================================
 // ENUM VALIDATOR
 // ============================================================================
@@ -112,14 +130,16 @@ func (v *EnumValidator[T]) Validate(ctx *ValidationContext, value any) map[strin
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.defaultValue != nil {
 			value = *v.defaultValue
 		} else if v.required {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		} else {
+			ctx.skip(skipReason("absent", nil, nil))
 			return nil
 		}
 	}
@@ -133,12 +153,14 @@ func (v *EnumValidator[T]) Validate(ctx *ValidationContext, value any) map[strin
 		// Try to convert from compatible types
 		converted, ok := convertToType[T](value)
 		if !ok {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("type", fmt.Sprintf("%s has invalid type", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "type", fmt.Sprintf("%s has invalid type", fieldName)))
 			return errors
 		}
 		typedValue = converted
 	}
 
+	ctx.enterRules()
+
 	// Check if value is in allowed values
 	found := false
 	for _, allowed := range v.values {
@@ -154,7 +176,7 @@ func (v *EnumValidator[T]) Validate(ctx *ValidationContext, value any) map[strin
 		for i, val := range v.values {
 			allowedStrs[i] = fmt.Sprintf("%v", val)
 		}
-		errors[fieldPath] = append(errors[fieldPath], v.msg("enum", fmt.Sprintf("%s must be one of: %s", fieldName, strings.Join(allowedStrs, ", "))))
+		errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "enum", fmt.Sprintf("%s must be one of: %s", fieldName, strings.Join(allowedStrs, ", "))))
 	}
 
 	if len(errors) == 0 {
@@ -163,11 +185,27 @@ func (v *EnumValidator[T]) Validate(ctx *ValidationContext, value any) map[strin
 	return errors
 }
 
-func (v *EnumValidator[T]) msg(rule, defaultMsg string) string {
+func (v *EnumValidator[T]) msg(ctx *ValidationContext, rule, defaultMsg string) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return msg
+		message = msg
 	}
-	return defaultMsg
+	ctx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *EnumValidator[T]) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, nil, nil)
+	if v.defaultValue != nil {
+		rules = append(rules, ruleDesc{name: "default", param: *v.defaultValue, whenNil: true})
+	}
+	return append(rules, ruleDesc{name: "type"}, ruleDesc{name: "enum", param: v.values})
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *EnumValidator[T]) Describe() Description {
+	return newDescription("enum", v.nullable, v.describeRules(), stringMessages(v.messages))
 }
 
 // ============================================================================
@@ -217,12 +255,14 @@ func (v *LiteralValidator[T]) Validate(ctx *ValidationContext, value any) map[st
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.required {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		}
+		ctx.skip(skipReason("absent", nil, nil))
 		return nil
 	}
 
@@ -235,15 +275,17 @@ func (v *LiteralValidator[T]) Validate(ctx *ValidationContext, value any) map[st
 		// Try to convert from compatible types
 		converted, ok := convertToType[T](value)
 		if !ok {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("type", fmt.Sprintf("%s has invalid type", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "type", fmt.Sprintf("%s has invalid type", fieldName)))
 			return errors
 		}
 		typedValue = converted
 	}
 
+	ctx.enterRules()
+
 	// Check exact match
 	if typedValue != v.value {
-		errors[fieldPath] = append(errors[fieldPath], v.msg("literal", fmt.Sprintf("%s must be exactly %v", fieldName, v.value)))
+		errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "literal", fmt.Sprintf("%s must be exactly %v", fieldName, v.value)))
 	}
 
 	if len(errors) == 0 {
@@ -252,18 +294,32 @@ func (v *LiteralValidator[T]) Validate(ctx *ValidationContext, value any) map[st
 	return errors
 }
 
-func (v *LiteralValidator[T]) msg(rule, defaultMsg string) string {
+func (v *LiteralValidator[T]) msg(ctx *ValidationContext, rule, defaultMsg string) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return msg
+		message = msg
 	}
-	return defaultMsg
+	ctx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *LiteralValidator[T]) describeRules() []ruleDesc {
+	return append(requiredRules(v.required, nil, nil), ruleDesc{name: "type"}, ruleDesc{name: "literal", param: v.value})
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *LiteralValidator[T]) Describe() Description {
+	return newDescription("literal", v.nullable, v.describeRules(), stringMessages(v.messages))
 }
 
 // ============================================================================
 // UNION VALIDATOR
 // ============================================================================
 
-// UnionValidator validates value against multiple validators (any of)
+// UnionValidator validates value against multiple validators (any of).
+// Only the matching validator's nested fields and Custom calls are reported
+// to Hooks and Explain; failed attempts are dropped.
 type UnionValidator struct {
 	validators []Validator
 	required   bool
@@ -306,33 +362,62 @@ func (v *UnionValidator) Validate(ctx *ValidationContext, value any) map[string]
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.required {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		}
+		ctx.skip(skipReason("absent", nil, nil))
 		return nil
 	}
 
+	ctx.enterRules()
+
 	// Try each validator - if any succeeds, the value is valid
+	// Reports of an attempt are buffered and kept only when it matches
+	attempt := *ctx
+	attempt.rec = nil
 	for _, validator := range v.validators {
-		errs := validator.Validate(ctx, value)
+		reports := &deferredReports{}
+		if ctx.reporting() {
+			attempt.reports = reports
+		}
+		errs := validator.Validate(&attempt, value)
 		if len(errs) == 0 {
+			reports.commit(ctx)
 			return nil // One validator passed
 		}
 	}
 
 	// All validators failed
-	errors[fieldPath] = append(errors[fieldPath], v.msg("union", fmt.Sprintf("%s does not match any of the expected types", fieldName)))
+	errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "union", fmt.Sprintf("%s does not match any of the expected types", fieldName)))
 	return errors
 }
 
-func (v *UnionValidator) msg(rule, defaultMsg string) string {
+func (v *UnionValidator) msg(ctx *ValidationContext, rule, defaultMsg string) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return msg
+		message = msg
+	}
+	ctx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *UnionValidator) describeRules() []ruleDesc {
+	return append(requiredRules(v.required, nil, nil), ruleDesc{name: "union", param: len(v.validators)})
+}
+
+// Describe returns a read-only description of the configured rules, with the
+// member validators in Variants
+func (v *UnionValidator) Describe() Description {
+	d := newDescription("union", v.nullable, v.describeRules(), stringMessages(v.messages))
+	for _, validator := range v.validators {
+		d.Variants = append(d.Variants, describeValidator(validator))
 	}
-	return defaultMsg
+	return d
 }
 
 // GetDBChecks returns database checks from all validators in the union
@@ -390,22 +475,36 @@ func (v *AnyValidator) Validate(ctx *ValidationContext, value any) map[string][]
 
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.required {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		}
+		ctx.skip(skipReason("absent", nil, nil))
 	}
 
 	return nil
 }
 
-func (v *AnyValidator) msg(rule, defaultMsg string) string {
+func (v *AnyValidator) msg(ctx *ValidationContext, rule, defaultMsg string) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return msg
+		message = msg
 	}
-	return defaultMsg
+	ctx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *AnyValidator) describeRules() []ruleDesc {
+	return requiredRules(v.required, nil, nil)
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *AnyValidator) Describe() Description {
+	return newDescription("any", v.nullable, v.describeRules(), stringMessages(v.messages))
 }
 
 // ============================================================================
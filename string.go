@@ -447,12 +447,63 @@ func (v *StringValidator) Exists(table, column string, wheres ...WhereClause) *S
 	return v
 }
 
+// ExistsAndLoad adds a database existence check that also loads the matched
+// row; use ValidateAndLoad to read it. The DBChecker must implement DBLoader.
+func (v *StringValidator) ExistsAndLoad(table, column string, wheres ...WhereClause) *StringValidator {
+	v.exists = &ExistsRule{Table: table, Column: column, Where: wheres, Load: true}
+	return v
+}
+
+// ExistsQuery adds a database existence check using a raw SQL template,
+// replacing Exists. ? binds args; {values} batches all values into one IN list
+// (the query must select the matching values first), while {value} runs the
+// query once per value and passes when it returns a row. The DBChecker must
+// implement RawQuerier. With Options.DBScope set, include the scope
+// conditions in the SQL and mark the rule Unscoped(); otherwise validation
+// fails with ErrScopeNotApplied.
+func (v *StringValidator) ExistsQuery(sql string, args ...any) *StringValidator {
+	v.exists = &ExistsRule{Query: &RawQuery{SQL: sql, Args: args}}
+	return v
+}
+
+// ExistsFunc adds a database existence check using a callback that receives
+// every value of this rule in one batch, replacing Exists. With
+// Options.DBScope set, apply DBScopeWhere(ctx, table) in the callback and mark
+// the rule Unscoped(); otherwise validation fails with ErrScopeNotApplied.
+func (v *StringValidator) ExistsFunc(fn ExistsFunc) *StringValidator {
+	v.exists = newExistsFuncRule(fn)
+	return v
+}
+
 // Unique adds database uniqueness check
 func (v *StringValidator) Unique(table, column string, ignore any, wheres ...WhereClause) *StringValidator {
 	v.unique = &UniqueRule{Table: table, Column: column, Ignore: ignore, Where: wheres}
 	return v
 }
 
+// IgnoreWhere excludes the row where column equals value from the Unique
+// check (e.g., IgnoreWhere("id", currentID) on update). Must follow Unique;
+// a nil value disables the exclusion.
+func (v *StringValidator) IgnoreWhere(column string, value any) *StringValidator {
+	if v.unique != nil {
+		v.unique.IgnoreColumn = column
+		v.unique.IgnoreValue = value
+	}
+	return v
+}
+
+// Unscoped exempts the Exists and Unique rules defined before it from
+// Options.DBScope (e.g., a global lookup table without tenant_id)
+func (v *StringValidator) Unscoped() *StringValidator {
+	if v.exists != nil {
+		v.exists.Unscoped = true
+	}
+	if v.unique != nil {
+		v.unique.Unscoped = true
+	}
+	return v
+}
+
 // Custom adds custom validation function
 func (v *StringValidator) Custom(fn func(value string, lookup Lookup) error) *StringValidator {
 	v.customFn = fn
@@ -509,11 +560,13 @@ func (v *StringValidator) Validate(ctx *ValidationContext, value any) map[string
 		Index: extractIndex(fieldPath),
 		Value: value,
 		Data:  DataAccessor(ctx.RootData),
+		rec:   ctx.rec,
 	}
 
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.defaultValue != nil {
@@ -528,6 +581,7 @@ func (v *StringValidator) Validate(ctx *ValidationContext, value any) map[string
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		} else {
+			ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 			return nil
 		}
 	}
@@ -571,9 +625,12 @@ func (v *StringValidator) Validate(ctx *ValidationContext, value any) map[string
 			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName), msgCtx))
 			return errors
 		}
+		ctx.skip(skipReason("empty", v.requiredIf, v.requiredUnless))
 		return nil
 	}
 
+	ctx.enterRules()
+
 	length := utf8.RuneCountInString(str)
 
 	// Min length (check for "length" message first if min == max, for Length() use case)
@@ -790,7 +847,7 @@ func (v *StringValidator) Validate(ctx *ValidationContext, value any) map[string
 		lookup := func(path string) LookupResult {
 			return lookupPath(ctx.RootData, path)
 		}
-		if err := v.customFn(str, lookup); err != nil {
+		if err := ctx.runCustom(func() error { return v.customFn(str, lookup) }); err != nil {
 			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error(), msgCtx))
 		}
 	}
@@ -824,7 +881,7 @@ func (v *StringValidator) GetDBChecks(fieldPath string, value any) []DBCheck {
 		checks = append(checks, DBCheck{
 			Field:    fieldPath,
 			Value:    str,
-			Rule:     ExistsRule{Table: v.unique.Table, Column: v.unique.Column, Where: v.unique.Where},
+			Rule:     ExistsRule{Table: v.unique.Table, Column: v.unique.Column, Where: v.unique.queryWheres(), Unscoped: v.unique.Unscoped},
 			IsUnique: true,
 			Ignore:   v.unique.Ignore,
 			Message:  v.messages["unique"],
@@ -835,11 +892,123 @@ func (v *StringValidator) GetDBChecks(fieldPath string, value any) []DBCheck {
 }
 
 func (v *StringValidator) msg(rule, defaultMsg string, msgCtx MessageContext) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
 		msgCtx.Rule = rule
-		return resolveMessage(msg, msgCtx)
+		message = resolveMessage(msg, msgCtx)
+	}
+	msgCtx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *StringValidator) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	if v.defaultValue != nil {
+		rules = append(rules, ruleDesc{name: "default", param: *v.defaultValue, whenNil: true})
 	}
-	return defaultMsg
+	rules = append(rules, ruleDesc{name: "type", param: "string"})
+	if v.trim {
+		rules = append(rules, ruleDesc{name: "trim", transform: true})
+	}
+	if v.lowercase {
+		rules = append(rules, ruleDesc{name: "lowercase", transform: true})
+	}
+	if v.uppercase {
+		rules = append(rules, ruleDesc{name: "uppercase", transform: true})
+	}
+	if len(v.transforms) > 0 {
+		rules = append(rules, ruleDesc{name: "transform", param: len(v.transforms), transform: true})
+	}
+
+	_, lengthMsg := v.messages["length"]
+	if v.minSet && v.maxSet && v.min == v.max && lengthMsg {
+		rules = append(rules, ruleDesc{name: "length", param: v.min})
+	} else {
+		if v.minSet {
+			rules = append(rules, ruleDesc{name: "min", param: v.min})
+		}
+		if v.maxSet {
+			rules = append(rules, ruleDesc{name: "max", param: v.max})
+		}
+	}
+
+	if v.email {
+		rules = append(rules, ruleDesc{name: "email"})
+	}
+	if v.url {
+		rules = append(rules, ruleDesc{name: "url"})
+	}
+	if v.startsWith != "" {
+		rules = append(rules, ruleDesc{name: "startsWith", param: v.startsWith})
+	}
+	if v.endsWith != "" {
+		rules = append(rules, ruleDesc{name: "endsWith", param: v.endsWith})
+	}
+	if v.contains != "" {
+		rules = append(rules, ruleDesc{name: "contains", param: v.contains})
+	}
+	if v.alpha {
+		rules = append(rules, ruleDesc{name: "alpha"})
+	}
+	if v.alphaNumeric {
+		rules = append(rules, ruleDesc{name: "alphaNumeric"})
+	}
+	if v.regex != nil {
+		rules = append(rules, ruleDesc{name: "regex", param: v.regexPattern})
+	}
+	if v.notRegex != nil {
+		rules = append(rules, ruleDesc{name: "notRegex", param: v.notRegex.String()})
+	}
+	if len(v.in) > 0 {
+		rules = append(rules, ruleDesc{name: "in", param: v.in})
+	}
+	if len(v.notIn) > 0 {
+		rules = append(rules, ruleDesc{name: "notIn", param: v.notIn})
+	}
+	if len(v.doesntStartWith) > 0 {
+		rules = append(rules, ruleDesc{name: "doesntStartWith", param: v.doesntStartWith})
+	}
+	if len(v.doesntEndWith) > 0 {
+		rules = append(rules, ruleDesc{name: "doesntEndWith", param: v.doesntEndWith})
+	}
+	if len(v.includes) > 0 {
+		rules = append(rules, ruleDesc{name: "includes", param: v.includes})
+	}
+
+	flags := []struct {
+		on   bool
+		name string
+	}{
+		{v.uuid, "uuid"}, {v.ip, "ip"}, {v.ipv4, "ipv4"}, {v.ipv6, "ipv6"},
+		{v.json, "json"}, {v.hexColor, "hexColor"}, {v.ascii, "ascii"},
+		{v.base64, "base64"}, {v.mac, "mac"}, {v.ulid, "ulid"}, {v.alphaDash, "alphaDash"},
+	}
+	for _, f := range flags {
+		if f.on {
+			rules = append(rules, ruleDesc{name: f.name})
+		}
+	}
+	if v.digitsSet {
+		rules = append(rules, ruleDesc{name: "digits", param: v.digitsLen})
+	}
+	if v.sameAs != "" {
+		rules = append(rules, ruleDesc{name: "sameAs", param: v.sameAs})
+	}
+	if v.differentFrom != "" {
+		rules = append(rules, ruleDesc{name: "differentFrom", param: v.differentFrom})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	return append(rules, dbRuleDescs(v.exists, v.unique)...)
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *StringValidator) Describe() Description {
+	d := newDescription("string", v.nullable, v.describeRules(), v.messages)
+	d.DB = describeDBRules(v.exists, v.unique)
+	return d
 }
 
 // Helper functions
@@ -140,20 +140,22 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 	// Handle nil
 	if value == nil {
 		if v.nullable {
+			ctx.skip(skipNullable)
 			return nil
 		}
 		if v.defaultValue != nil {
 			value = v.defaultValue.Format(v.format)
 		} else if v.required {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		} else if v.requiredIf != nil && v.requiredIf(ctx.RootData) {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		} else if v.requiredUnless != nil && !v.requiredUnless(ctx.RootData) {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 			return errors
 		} else {
+			ctx.skip(skipReason("absent", v.requiredIf, v.requiredUnless))
 			return nil
 		}
 	}
@@ -168,9 +170,10 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 	case string:
 		if val == "" {
 			if v.required {
-				errors[fieldPath] = append(errors[fieldPath], v.msg("required", fmt.Sprintf("%s is required", fieldName)))
+				errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "required", fmt.Sprintf("%s is required", fieldName)))
 				return errors
 			}
+			ctx.skip(skipReason("empty", nil, nil))
 			return nil
 		}
 		if v.timezone != nil {
@@ -179,14 +182,16 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 			t, err = time.Parse(v.format, val)
 		}
 		if err != nil {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("format", fmt.Sprintf("%s must be a valid time format", fieldName)))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "format", fmt.Sprintf("%s must be a valid time format", fieldName)))
 			return errors
 		}
 	default:
-		errors[fieldPath] = append(errors[fieldPath], v.msg("type", fmt.Sprintf("%s must be a time value", fieldName)))
+		errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "type", fmt.Sprintf("%s must be a time value", fieldName)))
 		return errors
 	}
 
+	ctx.enterRules()
+
 	// Create lookup function
 	lookup := func(path string) LookupResult {
 		return lookupPath(ctx.RootData, path)
@@ -194,7 +199,7 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 
 	// After validation
 	if v.after != nil && !t.After(*v.after) {
-		errors[fieldPath] = append(errors[fieldPath], v.msg("after", fmt.Sprintf("%s must be after %s", fieldName, v.after.Format(v.format))))
+		errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "after", fmt.Sprintf("%s must be after %s", fieldName, v.after.Format(v.format))))
 	}
 
 	// AfterField validation
@@ -211,12 +216,12 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 				}
 				if parseErr == nil {
 					if !t.After(afterTime) {
-						errors[fieldPath] = append(errors[fieldPath], v.msg("afterField", fmt.Sprintf("%s must be after %s", fieldName, v.afterField)))
+						errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "afterField", fmt.Sprintf("%s must be after %s", fieldName, v.afterField)))
 					}
 				}
 			} else if afterTime, ok := afterResult.Value().(time.Time); ok {
 				if !t.After(afterTime) {
-					errors[fieldPath] = append(errors[fieldPath], v.msg("afterField", fmt.Sprintf("%s must be after %s", fieldName, v.afterField)))
+					errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "afterField", fmt.Sprintf("%s must be after %s", fieldName, v.afterField)))
 				}
 			}
 		}
@@ -224,7 +229,7 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 
 	// Before validation
 	if v.before != nil && !t.Before(*v.before) {
-		errors[fieldPath] = append(errors[fieldPath], v.msg("before", fmt.Sprintf("%s must be before %s", fieldName, v.before.Format(v.format))))
+		errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "before", fmt.Sprintf("%s must be before %s", fieldName, v.before.Format(v.format))))
 	}
 
 	// BeforeField validation
@@ -241,12 +246,12 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 				}
 				if parseErr == nil {
 					if !t.Before(beforeTime) {
-						errors[fieldPath] = append(errors[fieldPath], v.msg("beforeField", fmt.Sprintf("%s must be before %s", fieldName, v.beforeField)))
+						errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "beforeField", fmt.Sprintf("%s must be before %s", fieldName, v.beforeField)))
 					}
 				}
 			} else if beforeTime, ok := beforeResult.Value().(time.Time); ok {
 				if !t.Before(beforeTime) {
-					errors[fieldPath] = append(errors[fieldPath], v.msg("beforeField", fmt.Sprintf("%s must be before %s", fieldName, v.beforeField)))
+					errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "beforeField", fmt.Sprintf("%s must be before %s", fieldName, v.beforeField)))
 				}
 			}
 		}
@@ -255,14 +260,14 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 	// Between validation
 	if v.betweenStart != nil && v.betweenEnd != nil {
 		if t.Before(*v.betweenStart) || t.After(*v.betweenEnd) {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("between", fmt.Sprintf("%s must be between %s and %s", fieldName, v.betweenStart.Format(v.format), v.betweenEnd.Format(v.format))))
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "between", fmt.Sprintf("%s must be between %s and %s", fieldName, v.betweenStart.Format(v.format), v.betweenEnd.Format(v.format))))
 		}
 	}
 
 	// Custom validation
 	if v.customFn != nil {
-		if err := v.customFn(t, lookup); err != nil {
-			errors[fieldPath] = append(errors[fieldPath], v.msg("custom", err.Error()))
+		if err := ctx.runCustom(func() error { return v.customFn(t, lookup) }); err != nil {
+			errors[fieldPath] = append(errors[fieldPath], v.msg(ctx, "custom", err.Error()))
 		}
 	}
 
@@ -272,9 +277,44 @@ func (v *TimeValidator) Validate(ctx *ValidationContext, value any) map[string][
 	return errors
 }
 
-func (v *TimeValidator) msg(rule, defaultMsg string) string {
+func (v *TimeValidator) msg(ctx *ValidationContext, rule, defaultMsg string) string {
+	message := defaultMsg
 	if msg, ok := v.messages[rule]; ok {
-		return msg
+		message = msg
+	}
+	ctx.recordFailure(rule, message)
+	return message
+}
+
+// describeRules lists the configured rules in evaluation order for Explain
+func (v *TimeValidator) describeRules() []ruleDesc {
+	rules := requiredRules(v.required, v.requiredIf, v.requiredUnless)
+	if v.defaultValue != nil {
+		rules = append(rules, ruleDesc{name: "default", param: v.defaultValue.Format(v.format), whenNil: true})
+	}
+	rules = append(rules, ruleDesc{name: "format", param: v.format}, ruleDesc{name: "type", param: "time"})
+	if v.after != nil {
+		rules = append(rules, ruleDesc{name: "after", param: v.after.Format(v.format)})
+	}
+	if v.afterField != "" {
+		rules = append(rules, ruleDesc{name: "afterField", param: v.afterField})
+	}
+	if v.before != nil {
+		rules = append(rules, ruleDesc{name: "before", param: v.before.Format(v.format)})
 	}
-	return defaultMsg
+	if v.beforeField != "" {
+		rules = append(rules, ruleDesc{name: "beforeField", param: v.beforeField})
+	}
+	if v.betweenStart != nil && v.betweenEnd != nil {
+		rules = append(rules, ruleDesc{name: "between", param: v.betweenStart.Format(v.format) + " - " + v.betweenEnd.Format(v.format)})
+	}
+	if v.customFn != nil {
+		rules = append(rules, ruleDesc{name: "custom"})
+	}
+	return rules
+}
+
+// Describe returns a read-only description of the configured rules
+func (v *TimeValidator) Describe() Description {
+	return newDescription("time", v.nullable, v.describeRules(), stringMessages(v.messages))
 }
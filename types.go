@@ -4,11 +4,27 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 )
 
 // Common errors
 var (
-	ErrNilDBConnection = errors.New("database connection is nil")
+	ErrNilDBConnection   = errors.New("database connection is nil")
+	ErrInvalidIdentifier = errors.New("invalid SQL identifier")
+	ErrInvalidOperator   = errors.New("invalid where operator")
+	ErrDBCheckFailed     = errors.New("database check failed")
+	ErrCircuitOpen       = errors.New("database circuit breaker is open")
+	ErrLoadNotSupported  = errors.New("DBChecker does not implement DBLoader")
+	ErrInvalidRawQuery   = errors.New("invalid raw exists query")
+	// ErrRawQueryNotSupported is returned for ExistsQuery rules when the
+	// DBChecker does not implement RawQuerier
+	ErrRawQueryNotSupported = errors.New("DBChecker does not implement RawQuerier")
+	// ErrScopeNotApplied is returned when Options.DBScope is set and an
+	// ExistsQuery or ExistsFunc rule is not marked Unscoped()
+	ErrScopeNotApplied = errors.New("DBScope cannot be applied to ExistsQuery or ExistsFunc rules; apply it in the rule and mark it Unscoped()")
+	// ErrWhereNotSupported is returned by adapters that cannot evaluate where
+	// clauses (e.g., KVSetAdapter)
+	ErrWhereNotSupported = errors.New("adapter does not support where clauses")
 )
 
 // DataObject represents the data to validate (parsed JSON)
@@ -40,6 +56,9 @@ type ValidationContext struct {
 	RootData DataObject
 	Path     []string
 	Options  *Options
+
+	rec     *fieldRecorder   // Records the current field for Hooks and Explain
+	reports *deferredReports // Set while trying a union branch; reports wait for a match
 }
 
 // FullPath returns the dot-notation path string from the path slice
@@ -59,21 +78,65 @@ type Options struct {
 	AbortEarly bool
 	DBChecker  DBChecker
 	Context    context.Context
+	// DBCaseInsensitive matches DB results ignoring string case, for
+	// case-insensitive collations (e.g., MySQL's default)
+	DBCaseInsensitive bool
+	// DBScope adds where clauses (e.g., tenant_id) to every DB check
+	DBScope *DBScope
+	// DBCheckMode controls whether DB checks run when other fields failed
+	DBCheckMode DBCheckMode
+	// DBErrorPolicy controls how DBChecker failures are reported
+	DBErrorPolicy DBErrorPolicy
+	// DBErrorMessage is the field message used with DBErrorAsFieldErrors
+	DBErrorMessage MessageArg
+	// DBConcurrency limits parallel DB queries (0 = one per batch group)
+	DBConcurrency int
+	// DBQueryTimeout bounds each DB query attempt (0 = context deadline only)
+	DBQueryTimeout time.Duration
+	// DBRetry retries failed DB queries with backoff (nil = no retry)
+	DBRetry *RetryPolicy
+	// DBCircuitBreaker short-circuits DB queries after repeated failures;
+	// share one breaker across calls
+	DBCircuitBreaker *CircuitBreaker
+	// Hooks receives lifecycle events for tracing and metrics (nil = none)
+	Hooks Hooks
+
+	trace *explainTrace // Set by Explain
 }
 
 // ValidationError holds all validation errors
 type ValidationError struct {
 	Errors map[string][]string
+	// DBError is set when a DBChecker failed; it wraps ErrDBCheckFailed
+	DBError error
 }
 
 func (e *ValidationError) Error() string {
 	return "validation failed"
 }
 
+// Unwrap exposes DBError so errors.Is(err, ErrDBCheckFailed) works
+func (e *ValidationError) Unwrap() error {
+	return e.DBError
+}
+
 func (e *ValidationError) HasErrors() bool {
 	return len(e.Errors) > 0
 }
 
+// Result holds the outcome of a successful ValidateAndLoad
+type Result struct {
+	Data DataObject
+	// Rows holds rows loaded by ExistsAndLoad rules, keyed by field path
+	Rows map[string]map[string]any
+}
+
+// Row returns the row loaded for a field path (e.g., "items.0.product_id")
+func (r *Result) Row(path string) (map[string]any, bool) {
+	row, ok := r.Rows[path]
+	return row, ok
+}
+
 // Lookup function for accessing other fields
 type Lookup func(path string) LookupResult
 
@@ -175,11 +238,19 @@ type DBChecker interface {
 	CheckExists(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error)
 }
 
+// DBLoader is an optional DBChecker extension used by ExistsAndLoad rules.
+// LoadRows returns the matched rows keyed by NormalizeDBKey of the column value.
+type DBLoader interface {
+	LoadRows(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]map[string]any, error)
+}
+
 // WhereClause for DB queries
 type WhereClause struct {
 	Column   string
 	Operator string
 	Value    any
+	Or       []WhereClause // Clauses joined with OR (Operator "OR")
+	Field    string        // Payload path whose value is used as Value at validation time
 }
 
 // Helper functions for where clauses
@@ -195,6 +266,49 @@ func WhereNot(column string, value any) WhereClause {
 	return WhereClause{Column: column, Operator: "!=", Value: value}
 }
 
+// WhereIn matches rows where column is one of values
+func WhereIn(column string, values ...any) WhereClause {
+	return WhereClause{Column: column, Operator: "IN", Value: values}
+}
+
+// WhereNotIn matches rows where column is none of values
+func WhereNotIn(column string, values ...any) WhereClause {
+	return WhereClause{Column: column, Operator: "NOT IN", Value: values}
+}
+
+// WhereNull matches rows where column IS NULL
+func WhereNull(column string) WhereClause {
+	return WhereClause{Column: column, Operator: "IS NULL"}
+}
+
+// WhereNotNull matches rows where column IS NOT NULL
+func WhereNotNull(column string) WhereClause {
+	return WhereClause{Column: column, Operator: "IS NOT NULL"}
+}
+
+// WhereLike matches rows where column LIKE pattern
+func WhereLike(column, pattern string) WhereClause {
+	return WhereClause{Column: column, Operator: "LIKE", Value: pattern}
+}
+
+// WhereBetween matches rows where column is between low and high (inclusive)
+func WhereBetween(column string, low, high any) WhereClause {
+	return WhereClause{Column: column, Operator: "BETWEEN", Value: []any{low, high}}
+}
+
+// WhereField compares column against another payload field, resolved at
+// validation time. fieldPath may be absolute ("country_id"), use "*" for the
+// indices of the validated field ("items.*.country_id"), or start with "." to
+// be relative to the validated field's parent (".country_id", "..country_id").
+func WhereField(column, operator, fieldPath string) WhereClause {
+	return WhereClause{Column: column, Operator: operator, Field: fieldPath}
+}
+
+// WhereOr groups clauses so that any of them may match
+func WhereOr(clauses ...WhereClause) WhereClause {
+	return WhereClause{Operator: "OR", Or: clauses}
+}
+
 // PathKey represents the current path in validation
 type PathKey struct {
 	Previous []string
@@ -225,13 +339,17 @@ type SchemaSliceObject = []SchemaObject
 
 // MessageContext provides contextual information for dynamic error messages
 type MessageContext struct {
-	Field string       // Field name (e.g., "email")
-	Path  string       // Full path (e.g., "users.0.email")
-	Index int          // Array index if inside array (-1 otherwise)
-	Value any          // The actual value being validated
-	Rule  string       // The validation rule that failed (e.g., "required", "min")
-	Param any          // Rule parameter if applicable (e.g., 3 for Min(3))
-	Data  DataAccessor // The root data object being validated (with Get method)
+	Field  string       // Field name (e.g., "email")
+	Path   string       // Full path (e.g., "users.0.email")
+	Index  int          // Array index if inside array (-1 otherwise)
+	Value  any          // The actual value being validated
+	Rule   string       // The validation rule that failed (e.g., "required", "min")
+	Param  any          // Rule parameter if applicable (e.g., 3 for Min(3))
+	Data   DataAccessor // The root data object being validated (with Get method)
+	Table  string       // Table for database rules (exists, unique)
+	Column string       // Column for database rules (exists, unique)
+
+	rec *fieldRecorder // See ValidationContext
 }
 
 // MessageFunc is a function that generates a custom error message
@@ -0,0 +1,350 @@
+// Package valethttp binds net/http requests to valet schemas: Bind decodes
+// JSON, form and multipart request bodies within a size limit and validates
+// them with the request context, and Middleware does the same for every
+// request of a route.
+package valethttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ezartsh/valet"
+)
+
+// Request body limits used by Bind when Options leaves them zero
+const (
+	DefaultMaxBodyBytes int64 = 10 << 20 // 10 MiB
+	DefaultMaxMemory    int64 = 32 << 20 // Multipart parts above this spill to disk
+)
+
+// Options configures Bind and Middleware
+type Options struct {
+	// Validation are the validation options (DBChecker, DBScope, ...); Context
+	// is set from the request
+	Validation valet.Options
+	// MaxBodyBytes limits the request body (0 = DefaultMaxBodyBytes, < 0 = no limit)
+	MaxBodyBytes int64
+	// MaxMemory is the multipart memory limit (0 = DefaultMaxMemory)
+	MaxMemory int64
+	// ErrorHandler writes the response when Bind fails in Middleware
+	// (nil = WriteBindError)
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// RequestError reports a request body that could not be decoded
+type RequestError struct {
+	Status int // http.StatusBadRequest, StatusRequestEntityTooLarge or StatusUnsupportedMediaType
+	Err    error
+}
+
+func (e *RequestError) Error() string {
+	return "valet: " + e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// Bind decodes the request body by Content-Type and validates it against
+// schema with the request context. JSON (the default when Content-Type is
+// missing), application/x-www-form-urlencoded and multipart/form-data bodies
+// are supported; form values are strings (use Coerce() on number and boolean
+// validators), "tags[]" keys are arrays, "address[city]" keys are nested
+// objects and uploaded files are *multipart.FileHeader values for
+// valet.FileValidator.
+//
+// Bind has no ResponseWriter, so a body over the limit is rejected without
+// asking the server to close the connection; Middleware passes its writer.
+//
+// Bind returns a *RequestError for unreadable bodies, an error wrapping
+// valet.ErrDBCheckFailed when a DB check failed under DBErrorFailClosed, or a
+// *valet.ValidationError.
+func Bind(r *http.Request, schema valet.Schema, opts ...Options) (valet.DataObject, error) {
+	var options Options
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	return bind(nil, r, schema, options)
+}
+
+// bind decodes and validates the request; w may be nil
+func bind(w http.ResponseWriter, r *http.Request, schema valet.Schema, options Options) (valet.DataObject, error) {
+	data, err := decodeRequest(w, r, options)
+	if err != nil {
+		return nil, err
+	}
+	return valet.ValidateWithDBContext(r.Context(), data, schema, options.Validation)
+}
+
+// Middleware validates each request with Bind before calling next. Failed
+// requests get the ErrorHandler response; the validated data of the others
+// is available to next through DataFromContext.
+func Middleware(schema valet.Schema, opts ...Options) func(http.Handler) http.Handler {
+	var options Options
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	onError := options.ErrorHandler
+	if onError == nil {
+		onError = WriteBindError
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, err := bind(w, r, schema, options)
+			if err != nil {
+				onError(w, r, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), dataContextKey{}, data)))
+		})
+	}
+}
+
+// dataContextKey stores the validated data in the request context
+type dataContextKey struct{}
+
+// DataFromContext returns the data validated by Middleware
+func DataFromContext(ctx context.Context) (valet.DataObject, bool) {
+	data, ok := ctx.Value(dataContextKey{}).(valet.DataObject)
+	return data, ok
+}
+
+// WriteBindError is the default Middleware error response: the errors by
+// field path with 422 (the OpenAPIValidationError schema), the status of a
+// *RequestError, or 503 when a DB check failed. Bodies are JSON.
+func WriteBindError(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		reqErr *RequestError
+		verr   *valet.ValidationError
+		status = http.StatusInternalServerError
+		body   any
+	)
+	switch {
+	case errors.As(err, &reqErr):
+		status = reqErr.Status
+		body = map[string]string{"message": reqErr.Err.Error()}
+	case errors.Is(err, valet.ErrDBCheckFailed):
+		status = http.StatusServiceUnavailable
+		body = map[string]string{"message": "validation is temporarily unavailable"}
+	case errors.As(err, &verr):
+		status = http.StatusUnprocessableEntity
+		body = verr.Errors
+	default:
+		body = map[string]string{"message": http.StatusText(status)}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decodeRequest reads the request body into a DataObject. w is passed to
+// http.MaxBytesReader so the server closes the connection after an oversized
+// body; it may be nil.
+func decodeRequest(w http.ResponseWriter, r *http.Request, options Options) (valet.DataObject, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return valet.DataObject{}, nil
+	}
+	limit := options.MaxBodyBytes
+	if limit == 0 {
+		limit = DefaultMaxBodyBytes
+	}
+	if limit > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+	}
+
+	mediaType := "application/json"
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		var err error
+		if mediaType, _, err = mime.ParseMediaType(ct); err != nil {
+			return nil, &RequestError{Status: http.StatusBadRequest, Err: fmt.Errorf("invalid Content-Type: %w", err)}
+		}
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return decodeJSONBody(r.Body)
+	case mediaType == "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, bodyError(err)
+		}
+		return formData(r.PostForm, nil)
+	case mediaType == "multipart/form-data":
+		maxMemory := options.MaxMemory
+		if maxMemory == 0 {
+			maxMemory = DefaultMaxMemory
+		}
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return nil, bodyError(err)
+		}
+		return formData(r.MultipartForm.Value, r.MultipartForm.File)
+	}
+	return nil, &RequestError{Status: http.StatusUnsupportedMediaType, Err: fmt.Errorf("unsupported Content-Type %q", mediaType)}
+}
+
+// bodyError classifies a body read error
+func bodyError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return &RequestError{Status: http.StatusRequestEntityTooLarge, Err: fmt.Errorf("request body exceeds %d bytes", tooLarge.Limit)}
+	}
+	return &RequestError{Status: http.StatusBadRequest, Err: err}
+}
+
+// decodeJSONBody decodes a single JSON object
+func decodeJSONBody(body io.Reader) (valet.DataObject, error) {
+	dec := json.NewDecoder(body)
+	var data valet.DataObject
+	if err := dec.Decode(&data); err != nil {
+		if err == io.EOF {
+			return valet.DataObject{}, nil
+		}
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			err = fmt.Errorf("request body must be a JSON object")
+		}
+		return nil, bodyError(err)
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		if err == nil {
+			err = fmt.Errorf("unexpected data after the JSON object")
+		}
+		return nil, bodyError(err)
+	}
+	if data == nil {
+		data = valet.DataObject{}
+	}
+	return data, nil
+}
+
+// formData converts form values and files to a DataObject. Bracketed keys
+// ("tags[]", "address[city]", "items[0][id]") build arrays and objects;
+// maps with keys 0..n-1 become arrays.
+func formData(values map[string][]string, files map[string][]*multipart.FileHeader) (valet.DataObject, error) {
+	root := map[string]any{}
+	set := func(key string, items []any) error {
+		path, appendTo, err := formKey(key)
+		if err != nil {
+			return &RequestError{Status: http.StatusBadRequest, Err: err}
+		}
+		var value any = items
+		if !appendTo && len(items) == 1 {
+			value = items[0]
+		}
+		if err := setFormValue(root, path, value); err != nil {
+			return &RequestError{Status: http.StatusBadRequest, Err: fmt.Errorf("form key %q: %w", key, err)}
+		}
+		return nil
+	}
+
+	for _, key := range sortedFormKeys(values) {
+		items := make([]any, len(values[key]))
+		for i, v := range values[key] {
+			items[i] = v
+		}
+		if err := set(key, items); err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range sortedFormKeys(files) {
+		items := make([]any, len(files[key]))
+		for i, f := range files[key] {
+			items[i] = f
+		}
+		if err := set(key, items); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range root {
+		root[k] = formArrays(v)
+	}
+	return root, nil
+}
+
+func sortedFormKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formKey splits "a[b][c][]" into ["a", "b", "c"] and reports the trailing []
+func formKey(key string) ([]string, bool, error) {
+	name, rest, bracketed := strings.Cut(key, "[")
+	if !bracketed {
+		return []string{key}, false, nil
+	}
+	path := []string{name}
+	rest = "[" + rest
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return nil, false, fmt.Errorf("malformed form key %q", key)
+		}
+		segment := rest[1:end]
+		rest = rest[end+1:]
+		if segment == "" {
+			if rest != "" {
+				return nil, false, fmt.Errorf("form key %q: [] must be last", key)
+			}
+			return path, true, nil
+		}
+		path = append(path, segment)
+	}
+	return path, false, nil
+}
+
+// setFormValue stores value at path, creating nested objects
+func setFormValue(root map[string]any, path []string, value any) error {
+	current := root
+	for _, segment := range path[:len(path)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			if _, exists := current[segment]; exists {
+				return fmt.Errorf("%q is both a value and an object", segment)
+			}
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+	last := path[len(path)-1]
+	if _, exists := current[last]; exists {
+		return fmt.Errorf("%q is both a value and an object", last)
+	}
+	current[last] = value
+	return nil
+}
+
+// formArrays converts objects keyed 0..n-1 to arrays
+func formArrays(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+	for k, child := range m {
+		m[k] = formArrays(child)
+	}
+	items := make([]any, len(m))
+	for k, child := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != k {
+			return m
+		}
+		items[i] = child
+	}
+	if len(items) == 0 {
+		return m
+	}
+	return items
+}
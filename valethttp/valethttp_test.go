@@ -0,0 +1,190 @@
+package valethttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ezartsh/valet"
+)
+
+func TestBind_JSON(t *testing.T) {
+	schema := valet.Schema{
+		"email": valet.String().Required().Email(),
+		"age":   valet.Int().Min(18),
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email": "a@example.com", "age": 30}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	data, err := Bind(r, schema)
+	if err != nil {
+		t.Fatalf("Bind error: %v", err)
+	}
+	if data["email"] != "a@example.com" || data["age"] != float64(30) {
+		t.Errorf("Unexpected data: %v", data)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email": "nope", "age": 17}`))
+	_, err = Bind(r, schema)
+	var verr *valet.ValidationError
+	if !errors.As(err, &verr) || len(verr.Errors["email"]) == 0 || len(verr.Errors["age"]) == 0 {
+		t.Errorf("Expected validation errors, got %v", err)
+	}
+}
+
+func TestBind_Form(t *testing.T) {
+	schema := valet.Schema{
+		"name": valet.String().Required(),
+		"age":  valet.Int().Coerce().Min(18),
+		"tags": valet.Array().Min(2).Of(valet.String()),
+		"address": valet.Object().Shape(valet.Schema{
+			"city": valet.String().Required(),
+		}),
+		"items": valet.Array().Of(valet.Object().Shape(valet.Schema{"id": valet.String().Required()})),
+	}
+	form := url.Values{
+		"name":          {"Ann"},
+		"age":           {"30"},
+		"tags[]":        {"go", "zod"},
+		"address[city]": {"Berlin"},
+		"items[0][id]":  {"a"},
+		"items[1][id]":  {"b"},
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	data, err := Bind(r, schema)
+	if err != nil {
+		t.Fatalf("Bind error: %v", err)
+	}
+	if tags, ok := data["tags"].([]any); !ok || len(tags) != 2 {
+		t.Errorf("Expected tags array, got %#v", data["tags"])
+	}
+	if address, ok := data["address"].(map[string]any); !ok || address["city"] != "Berlin" {
+		t.Errorf("Expected address object, got %#v", data["address"])
+	}
+	if items, ok := data["items"].([]any); !ok || len(items) != 2 || items[1].(map[string]any)["id"] != "b" {
+		t.Errorf("Expected items array, got %#v", data["items"])
+	}
+}
+
+func TestBind_Multipart(t *testing.T) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	_ = mw.WriteField("title", "Report")
+	part, _ := mw.CreateFormFile("document", "report.txt")
+	_, _ = part.Write([]byte("hello"))
+	_ = mw.Close()
+
+	schema := valet.Schema{
+		"title":    valet.String().Required(),
+		"document": valet.File().Required().Max(1024).Extensions("txt"),
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", &body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	data, err := Bind(r, schema)
+	if err != nil {
+		t.Fatalf("Bind error: %v", err)
+	}
+	if fh, ok := data["document"].(*multipart.FileHeader); !ok || fh.Filename != "report.txt" {
+		t.Errorf("Expected file header, got %#v", data["document"])
+	}
+}
+
+func TestBind_RequestErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+		opts        Options
+		status      int
+	}{
+		{"malformed JSON", "application/json", `{"a":`, Options{}, http.StatusBadRequest},
+		{"JSON array", "application/json", `[1]`, Options{}, http.StatusBadRequest},
+		{"trailing data", "application/json", `{} {}`, Options{}, http.StatusBadRequest},
+		{"too large", "application/json", `{"a": "0123456789"}`, Options{MaxBodyBytes: 8}, http.StatusRequestEntityTooLarge},
+		{"unsupported", "text/plain", "a", Options{}, http.StatusUnsupportedMediaType},
+		{"bad form key", "application/x-www-form-urlencoded", "a[b=1", Options{}, http.StatusBadRequest},
+		{"form conflict", "application/x-www-form-urlencoded", "a=1&a[b]=2", Options{}, http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+		r.Header.Set("Content-Type", tc.contentType)
+		_, err := Bind(r, valet.Schema{}, tc.opts)
+		var reqErr *RequestError
+		if !errors.As(err, &reqErr) || reqErr.Status != tc.status {
+			t.Errorf("%s: expected status %d, got %v", tc.name, tc.status, err)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	schema := valet.Schema{"email": valet.String().Required().Email()}
+	var got valet.DataObject
+	handler := Middleware(schema)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = DataFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email": "a@example.com"}`)))
+	if w.Code != http.StatusNoContent || got["email"] != "a@example.com" {
+		t.Errorf("Expected data in context, got %d %v", w.Code, got)
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"email": "nope"}`)))
+	var body map[string][]string
+	if w.Code != http.StatusUnprocessableEntity || json.Unmarshal(w.Body.Bytes(), &body) != nil || len(body["email"]) == 0 {
+		t.Errorf("Expected 422 with errors, got %d %s", w.Code, w.Body)
+	}
+
+	if _, ok := DataFromContext(context.Background()); ok {
+		t.Error("Expected no data outside Middleware")
+	}
+}
+
+func TestMiddleware_ErrorHandlerAndDB(t *testing.T) {
+	checker := valet.FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []valet.WhereClause) (map[any]bool, error) {
+		return nil, errors.New("connection refused")
+	})
+	var handled error
+	handler := Middleware(valet.Schema{"id": valet.Int().Exists("users", "id")}, Options{
+		Validation: valet.Options{DBChecker: checker},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			handled = err
+			WriteBindError(w, r, err)
+		},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Handler must not run")
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id": 1}`)))
+	if w.Code != http.StatusServiceUnavailable || !errors.Is(handled, valet.ErrDBCheckFailed) {
+		t.Errorf("Expected 503, got %d (%v)", w.Code, handled)
+	}
+}
+
+func TestMiddleware_TooLargeClosesConnection(t *testing.T) {
+	handler := Middleware(valet.Schema{}, Options{MaxBodyBytes: 8})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Handler must not run")
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(`{"a": "0123456789"}`))
+	if err != nil {
+		t.Fatalf("Post error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge || !resp.Close {
+		t.Errorf("Expected 413 with Connection: close, got %d (close=%v)", resp.StatusCode, resp.Close)
+	}
+}
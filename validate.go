@@ -2,8 +2,12 @@ package valet
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
 // ============================================================================
@@ -32,11 +36,24 @@ func releaseDBCheckSlice(s *[]DBCheck) {
 
 // batchGroup holds checks for a single table+column+where combination
 type batchGroup struct {
+	key    string
 	table  string
 	column string
 	wheres []WhereClause
 	checks []DBCheck
 	values []any
+	unique bool // Group contains Unique checks
+	load   bool // Group contains ExistsAndLoad checks
+	query  *RawQuery
+	fn     ExistsFunc
+}
+
+// context returns the context for the group's query, marking Unique checks
+func (g *batchGroup) context(ctx context.Context) context.Context {
+	if g.unique {
+		return withUniqueCheck(ctx)
+	}
+	return ctx
 }
 
 // batchGroupPool reuses batchGroup instances
@@ -53,9 +70,14 @@ func getBatchGroup() *batchGroup {
 	g := batchGroupPool.Get().(*batchGroup)
 	g.checks = g.checks[:0]
 	g.values = g.values[:0]
+	g.key = ""
 	g.wheres = nil
 	g.table = ""
 	g.column = ""
+	g.unique = false
+	g.load = false
+	g.query = nil
+	g.fn = nil
 	return g
 }
 
@@ -88,8 +110,7 @@ func makeBatchKey(table, column string, wheres []WhereClause) string {
 
 	for _, w := range wheres {
 		sb.WriteByte(':')
-		sb.WriteString(w.Column)
-		sb.WriteString(w.Operator)
+		writeWhereKey(sb, w)
 	}
 
 	return sb.String()
@@ -101,40 +122,108 @@ func makeBatchKey(table, column string, wheres []WhereClause) string {
 
 // Validate validates data against a schema
 func Validate(data DataObject, schema Schema, opts ...Options) *ValidationError {
+	_, err := validate(data, schema, opts...)
+	return err
+}
+
+// ValidateAndLoad validates data like Validate and also returns the rows
+// matched by ExistsAndLoad rules, so handlers need no second query
+func ValidateAndLoad(data DataObject, schema Schema, opts ...Options) (*Result, *ValidationError) {
+	rows, err := validate(data, schema, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		rows = make(map[string]map[string]any)
+	}
+	return &Result{Data: data, Rows: rows}, nil
+}
+
+// validate runs the validation and returns loaded rows keyed by field path
+func validate(data DataObject, schema Schema, opts ...Options) (map[string]map[string]any, *ValidationError) {
 	var options Options
 	if len(opts) > 0 {
 		options = opts[0]
 	}
+	if options.Context == nil {
+		options.Context = context.Background()
+	}
 
-	ctx := &ValidationContext{
-		Ctx:      options.Context,
-		RootData: data,
-		Path:     []string{},
-		Options:  &options,
+	if options.Hooks == nil {
+		return runValidation(data, schema, &options)
 	}
 
-	if ctx.Ctx == nil {
-		ctx.Ctx = context.Background()
+	start := time.Now()
+	if hctx := options.Hooks.OnValidationStart(options.Context, ValidationStartEvent{Fields: len(schema)}); hctx != nil {
+		options.Context = hctx
 	}
+	rows, err := runValidation(data, schema, &options)
 
-	allErrors := make(map[string][]string)
+	event := ValidationEndEvent{Duration: time.Since(start), Passed: err == nil}
+	if err != nil {
+		event.Errors = len(err.Errors)
+		event.DBError = err.DBError
+	}
+	options.Hooks.OnValidationEnd(options.Context, event)
+	return rows, err
+}
 
+// runValidation validates the fields and runs the batched DB checks
+func runValidation(data DataObject, schema Schema, options *Options) (map[string]map[string]any, *ValidationError) {
 	// Get pooled slice for DB checks
 	dbChecksPtr := getDBCheckSlice()
 	defer releaseDBCheckSlice(dbChecksPtr)
 	dbChecks := dbChecksPtr
 
+	allErrors, aborted := validateFields(data, schema, options, dbChecks)
+	if aborted {
+		return nil, &ValidationError{Errors: allErrors}
+	}
+
+	checks, scopeErr := prepareDBChecks(*dbChecks, allErrors, data, options)
+	if scopeErr != nil {
+		// A misconfigured scope fails closed whatever the DBErrorPolicy
+		return nil, &ValidationError{Errors: allErrors, DBError: scopeErr}
+	}
+
+	var (
+		dbErr error
+		rows  map[string]map[string]any
+	)
+	if options.DBChecker != nil && len(checks) > 0 {
+		var dbErrors map[string][]string
+		dbErrors, rows, dbErr = executeBatchedDBChecks(options.Context, options.DBChecker, checks, data, options)
+		for field, errs := range dbErrors {
+			allErrors[field] = append(allErrors[field], errs...)
+		}
+		if options.trace != nil {
+			options.trace.applyDBResults(checks, dbErrors, dbErr)
+		}
+	}
+
+	if len(allErrors) > 0 || dbErr != nil {
+		return nil, &ValidationError{Errors: allErrors, DBError: dbErr}
+	}
+
+	return rows, nil
+}
+
+// validateFields runs the local rules of every field and collects DB checks.
+// aborted is true when AbortEarly stopped at a failing field.
+func validateFields(data DataObject, schema Schema, options *Options, dbChecks *[]DBCheck) (map[string][]string, bool) {
+	allErrors := make(map[string][]string)
+
 	// Validate each field
 	for field, validator := range schema {
 		fieldCtx := &ValidationContext{
-			Ctx:      ctx.Ctx,
+			Ctx:      options.Context,
 			RootData: data,
 			Path:     []string{field},
-			Options:  ctx.Options,
+			Options:  options,
 		}
 
 		value := data[field]
-		fieldErrors := validator.Validate(fieldCtx, value)
+		fieldErrors := validateField(fieldCtx, validator, value)
 
 		// Merge field errors into allErrors
 		for path, errs := range fieldErrors {
@@ -142,7 +231,7 @@ func Validate(data DataObject, schema Schema, opts ...Options) *ValidationError
 		}
 
 		if len(fieldErrors) > 0 && options.AbortEarly {
-			return &ValidationError{Errors: allErrors}
+			return allErrors, true
 		}
 
 		// Collect DB checks
@@ -152,19 +241,31 @@ func Validate(data DataObject, schema Schema, opts ...Options) *ValidationError
 		}
 	}
 
-	// Execute DB checks if we have a checker and no errors so far
-	if options.DBChecker != nil && len(*dbChecks) > 0 && len(allErrors) == 0 {
-		dbErrors := executeBatchedDBChecks(ctx.Ctx, options.DBChecker, *dbChecks)
-		for field, errs := range dbErrors {
-			allErrors[field] = errs
+	return allErrors, false
+}
+
+// prepareDBChecks merges scope clauses, resolves WhereField clauses and drops
+// the checks that must not run because of local errors. The error reports
+// rules the scope cannot be applied to.
+func prepareDBChecks(checks []DBCheck, allErrors map[string][]string, data DataObject, options *Options) ([]DBCheck, error) {
+	// Merge scope clauses, then resolve clauses that reference payload fields
+	scopeErr := applyDBScope(checks, options.DBScope)
+	for i := range checks {
+		check := &checks[i]
+		if hasWhereFields(check.Rule.Where) {
+			check.Rule.Where = resolveWhereFields(check.Rule.Where, check.Field, data)
 		}
 	}
 
+	// Execute DB checks only when there are no errors so far, or per field
+	// when DBCheckPerField is set
 	if len(allErrors) > 0 {
-		return &ValidationError{Errors: allErrors}
+		if options.DBCheckMode == DBCheckPerField {
+			return filterDBChecks(checks, allErrors), scopeErr
+		}
+		return nil, scopeErr
 	}
-
-	return nil
+	return checks, scopeErr
 }
 
 // Parse is an alias for Validate (Zod-like naming)
@@ -181,6 +282,31 @@ func SafeParse(data DataObject, schema Schema, opts ...Options) (DataObject, *Va
 	return data, nil
 }
 
+// filterDBChecks drops checks for fields that failed local validation
+func filterDBChecks(checks []DBCheck, errs map[string][]string) []DBCheck {
+	filtered := make([]DBCheck, 0, len(checks))
+	for _, check := range checks {
+		if !hasPathErrors(check.Field, errs) {
+			filtered = append(filtered, check)
+		}
+	}
+	return filtered
+}
+
+// hasPathErrors reports whether path or any of its parents has errors
+func hasPathErrors(path string, errs map[string][]string) bool {
+	for {
+		if len(errs[path]) > 0 {
+			return true
+		}
+		i := strings.LastIndexByte(path, '.')
+		if i < 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
+
 // ValidateWithDB validates data with database checks using provided DBChecker
 func ValidateWithDB(ctx context.Context, data DataObject, schema Schema, checker DBChecker) *ValidationError {
 	return Validate(data, schema, Options{
@@ -189,11 +315,17 @@ func ValidateWithDB(ctx context.Context, data DataObject, schema Schema, checker
 	})
 }
 
-// ValidateWithDBContext validates data with full options including DB checker
+// ValidateWithDBContext validates data with full options including DB checker.
+// With the default DBErrorFailClosed policy a DBChecker failure is returned as
+// an error wrapping ErrDBCheckFailed instead of a *ValidationError, so callers
+// can distinguish infrastructure failures (503) from invalid input (422).
 func ValidateWithDBContext(ctx context.Context, data DataObject, schema Schema, opts Options) (DataObject, error) {
 	opts.Context = ctx
 	err := Validate(data, schema, opts)
 	if err != nil {
+		if err.DBError != nil && opts.DBErrorPolicy == DBErrorFailClosed {
+			return nil, err.DBError
+		}
 		return nil, err
 	}
 	return data, nil
@@ -207,60 +339,68 @@ func ValidateWithDBContext(ctx context.Context, data DataObject, schema Schema,
 type batchResult struct {
 	group     *batchGroup
 	existsMap map[any]bool
+	rows      map[any]map[string]any // Loaded rows for ExistsAndLoad groups
 	err       error
+	loadErr   error // Rows could not be loaded; fails only the ExistsAndLoad checks
+	attempts  int   // Queries run, including retries
 }
 
-// executeBatchedDBChecks runs all DB checks with batching and parallel execution
-func executeBatchedDBChecks(ctx context.Context, checker DBChecker, checks []DBCheck) map[string][]string {
+// executeBatchedDBChecks runs all DB checks with batching and parallel execution.
+// Field errors and loaded rows are returned by field path; DBChecker failures
+// are returned as a joined *DBCheckError according to the DB error policy.
+func executeBatchedDBChecks(ctx context.Context, checker DBChecker, checks []DBCheck, data DataObject, opts *Options) (map[string][]string, map[string]map[string]any, error) {
 	if len(checks) == 0 {
-		return nil
+		return nil, nil, nil
 	}
 
-	// Group checks by table+column+where for batching
-	// Pre-allocate with estimated size
-	groups := make(map[string]*batchGroup, len(checks)/2+1)
-	groupList := make([]*batchGroup, 0, len(checks)/2+1) // Track for cleanup
-
-	for _, check := range checks {
-		key := makeBatchKey(check.Rule.Table, check.Rule.Column, check.Rule.Where)
-		if groups[key] == nil {
-			g := getBatchGroup()
-			g.table = check.Rule.Table
-			g.column = check.Rule.Column
-			g.wheres = check.Rule.Where
-			groups[key] = g
-			groupList = append(groupList, g)
-		}
-		groups[key].checks = append(groups[key].checks, check)
-		groups[key].values = append(groups[key].values, check.Value)
+	if opts != nil && opts.DBScope != nil {
+		// ExistsFunc callbacks read the scope with DBScopeWhere
+		ctx = context.WithValue(ctx, dbScopeKey{}, opts.DBScope)
 	}
+	groups := groupDBChecks(checks)
 
 	// Defer cleanup of all groups
 	defer func() {
-		for _, g := range groupList {
+		for _, g := range groups {
 			releaseBatchGroup(g)
 		}
 	}()
 
-	errs := make(map[string][]string)
+	out := newDBOutcome()
+	var dbErrs []error
 
 	// For single group, execute directly (no goroutine overhead)
 	if len(groups) == 1 {
-		for _, group := range groups {
-			existsMap, err := checker.CheckExists(ctx, group.table, group.column, group.values, group.wheres)
-			processGroupResult(group, existsMap, err, errs)
+		result := runGroupQuery(ctx, checker, groups[0], opts)
+		if dbErr := processGroupResult(result, out, data, opts); dbErr != nil {
+			dbErrs = append(dbErrs, dbErr)
 		}
-		return errs
+		out.flushSummaries(data)
+		return out.errs, out.loaded, errors.Join(dbErrs...)
 	}
 
-	// Multiple groups: execute in parallel
+	// Multiple groups: execute in parallel, bounded by DBConcurrency
 	results := make(chan batchResult, len(groups))
 	var wg sync.WaitGroup
 
+	var sem chan struct{}
+	if opts != nil && opts.DBConcurrency > 0 && opts.DBConcurrency < len(groups) {
+		sem = make(chan struct{}, opts.DBConcurrency)
+	}
+
 	for _, group := range groups {
 		wg.Add(1)
 		go func(g *batchGroup) {
 			defer wg.Done()
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					results <- batchResult{group: g, existsMap: nil, err: ctx.Err()}
+					return
+				}
+			}
 			// Check context cancellation before executing
 			select {
 			case <-ctx.Done():
@@ -268,8 +408,7 @@ func executeBatchedDBChecks(ctx context.Context, checker DBChecker, checks []DBC
 				return
 			default:
 			}
-			existsMap, err := checker.CheckExists(ctx, g.table, g.column, g.values, g.wheres)
-			results <- batchResult{group: g, existsMap: existsMap, err: err}
+			results <- runGroupQuery(ctx, checker, g, opts)
 		}(group)
 	}
 
@@ -281,56 +420,271 @@ func executeBatchedDBChecks(ctx context.Context, checker DBChecker, checks []DBC
 
 	// Collect results
 	for result := range results {
-		processGroupResult(result.group, result.existsMap, result.err, errs)
+		if dbErr := processGroupResult(result, out, data, opts); dbErr != nil {
+			dbErrs = append(dbErrs, dbErr)
+		}
 	}
 
-	return errs
+	out.flushSummaries(data)
+	return out.errs, out.loaded, errors.Join(dbErrs...)
 }
 
-// processGroupResult processes the result of a single batch query
-func processGroupResult(group *batchGroup, existsMap map[any]bool, err error, errs map[string][]string) {
-	if err != nil {
-		// On DB error, add error to all fields in this group
-		for _, check := range group.checks {
-			errs[check.Field] = append(errs[check.Field], "database error: "+err.Error())
+// groupDBChecks groups checks by batch key (table+column+where, raw query or
+// callback) in first-seen order. Groups come from the pool and must be
+// released by the caller.
+func groupDBChecks(checks []DBCheck) []*batchGroup {
+	// Pre-allocate with estimated size
+	groups := make(map[string]*batchGroup, len(checks)/2+1)
+	groupList := make([]*batchGroup, 0, len(checks)/2+1)
+
+	for _, check := range checks {
+		key := check.Rule.batchKey()
+		g := groups[key]
+		if g == nil {
+			g = getBatchGroup()
+			g.key = key
+			g.table = check.Rule.Table
+			g.column = check.Rule.Column
+			g.wheres = check.Rule.Where
+			g.query = check.Rule.Query
+			g.fn = check.Rule.Func
+			groups[key] = g
+			groupList = append(groupList, g)
 		}
+		g.unique = g.unique || check.IsUnique
+		g.load = g.load || (check.Rule.Load && !check.IsUnique)
+		g.checks = append(g.checks, check)
+		g.values = append(g.values, check.Value)
+	}
+
+	return groupList
+}
+
+// dbOutcome collects field errors, loaded rows and array summaries across
+// batch groups
+type dbOutcome struct {
+	errs      map[string][]string
+	loaded    map[string]map[string]any
+	summaries map[string]*dbSummary
+}
+
+// dbSummary collects the failing values of a summarized array rule
+type dbSummary struct {
+	check  DBCheck // First failing check, for the rule and message
+	failed []dbSummaryItem
+}
+
+type dbSummaryItem struct {
+	index int
+	value any
+}
+
+func newDBOutcome() *dbOutcome {
+	return &dbOutcome{
+		errs:   make(map[string][]string),
+		loaded: make(map[string]map[string]any),
+	}
+}
+
+// fail records a failed check, either on its own path or in its array summary
+func (o *dbOutcome) fail(check DBCheck, msgCtx MessageContext, defaultMsg string) {
+	if check.SummaryPath != "" {
+		key := check.SummaryPath + "\x00" + msgCtx.Rule
+		if o.summaries == nil {
+			o.summaries = make(map[string]*dbSummary)
+		}
+		summary := o.summaries[key]
+		if summary == nil {
+			summary = &dbSummary{check: check}
+			o.summaries[key] = summary
+		}
+		summary.failed = append(summary.failed, dbSummaryItem{index: extractIndex(check.Field), value: check.Value})
 		return
 	}
 
-	for _, check := range group.checks {
-		exists := existsMap[check.Value]
+	errMsg := defaultMsg
+	if check.Message != nil {
+		errMsg = resolveMessage(check.Message, msgCtx)
+	}
+	o.errs[check.Field] = append(o.errs[check.Field], errMsg)
+}
 
-		// Create message context for resolving dynamic messages
-		// Note: Data is nil here as DB checks don't have access to root data
+// flushSummaries writes one error per summarized array rule
+func (o *dbOutcome) flushSummaries(data DataObject) {
+	keys := make([]string, 0, len(o.summaries))
+	for key := range o.summaries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		summary := o.summaries[key]
+		sort.Slice(summary.failed, func(i, j int) bool { return summary.failed[i].index < summary.failed[j].index })
+
+		values := make([]any, len(summary.failed))
+		for i, item := range summary.failed {
+			values[i] = item.value
+		}
+
+		check := summary.check
+		path := check.SummaryPath
 		msgCtx := MessageContext{
-			Field: check.Field,
-			Path:  check.Field,
-			Index: extractIndex(check.Field),
-			Value: check.Value,
+			Field:  lastPathSegment(path),
+			Path:   path,
+			Index:  extractIndex(path),
+			Value:  values,
+			Param:  check.SummaryTotal,
+			Data:   DataAccessor(data),
+			Table:  check.Rule.Table,
+			Column: check.Rule.Column,
 		}
 
+		var errMsg string
+		if check.IsUnique {
+			msgCtx.Rule = "unique"
+			errMsg = fmt.Sprintf("%d of %d %s already exist", len(values), check.SummaryTotal, msgCtx.Field)
+		} else {
+			msgCtx.Rule = "exists"
+			errMsg = fmt.Sprintf("all %d %s must exist", check.SummaryTotal, msgCtx.Field)
+		}
+		if check.Message != nil {
+			errMsg = resolveMessage(check.Message, msgCtx)
+		}
+		o.errs[path] = append(o.errs[path], errMsg)
+	}
+}
+
+// processGroupResult processes the result of a single batch query and returns
+// a *DBCheckError when the query failed, or rows could not be loaded for the
+// ExistsAndLoad checks, and the policy reports it
+func processGroupResult(result batchResult, out *dbOutcome, data DataObject, opts *Options) error {
+	group := result.group
+	if result.err != nil {
+		return handleGroupError(group, result.err, out, data, opts)
+	}
+
+	var loadErr error
+	if result.loadErr != nil {
+		loadGroup := *group
+		loadGroup.checks = nil
+		for _, check := range group.checks {
+			if check.Rule.Load && !check.IsUnique {
+				loadGroup.checks = append(loadGroup.checks, check)
+			}
+		}
+		loadErr = handleGroupError(&loadGroup, result.loadErr, out, data, opts)
+	}
+
+	// Normalize result keys so payload and driver types compare equal
+	fold := opts != nil && opts.DBCaseInsensitive
+	found := make(map[any]bool, len(result.existsMap))
+	for k, ok := range result.existsMap {
+		if ok {
+			found[normalizeDBKeyFold(k, fold)] = true
+		}
+	}
+	var rows map[any]map[string]any
+	if len(result.rows) > 0 {
+		rows = make(map[any]map[string]any, len(result.rows))
+		for k, row := range result.rows {
+			rows[normalizeDBKeyFold(k, fold)] = row
+		}
+	}
+
+	for _, check := range group.checks {
+		if result.loadErr != nil && check.Rule.Load && !check.IsUnique {
+			continue
+		}
+		key := normalizeDBKeyFold(check.Value, fold)
+		exists := found[key]
+
+		// Create message context for resolving dynamic messages
+		msgCtx := dbMessageContext(check, data)
+
 		if check.IsUnique {
 			// For unique: should NOT exist (unless it's the ignored value)
-			if exists && check.Value != check.Ignore {
-				var errMsg string
-				if check.Message != nil {
-					errMsg = resolveMessage(check.Message, msgCtx)
-				} else {
-					errMsg = check.Field + " already exists"
-				}
-				errs[check.Field] = append(errs[check.Field], errMsg)
+			ignored := check.Ignore != nil && key == normalizeDBKeyFold(check.Ignore, fold)
+			if exists && !ignored {
+				msgCtx.Rule = "unique"
+				out.fail(check, msgCtx, check.Field+" already exists")
 			}
 		} else {
 			// For exists: should exist
+			if exists && check.Rule.Load && rows != nil {
+				out.loaded[check.Field] = rows[key]
+			}
 			if !exists {
-				var errMsg string
-				if check.Message != nil {
-					errMsg = resolveMessage(check.Message, msgCtx)
-				} else {
-					errMsg = check.Field + " does not exist"
-				}
-				errs[check.Field] = append(errs[check.Field], errMsg)
+				msgCtx.Rule = "exists"
+				out.fail(check, msgCtx, check.Field+" does not exist")
+			}
+		}
+	}
+
+	return loadErr
+}
+
+// handleGroupError applies the DB error policy to a failed batch query
+func handleGroupError(group *batchGroup, err error, out *dbOutcome, data DataObject, opts *Options) error {
+	policy := DBErrorFailClosed
+	if opts != nil {
+		policy = opts.DBErrorPolicy
+	}
+
+	fields := make([]string, len(group.checks))
+	for i, check := range group.checks {
+		fields[i] = check.Field
+	}
+	dbErr := &DBCheckError{Table: group.table, Column: group.column, Fields: fields, Err: err}
+
+	switch policy {
+	case DBErrorFailOpen:
+		return nil
+	case DBErrorAsFieldErrors:
+		reported := make(map[string]bool)
+		for _, check := range group.checks {
+			// Summarized arrays get a single message on the array path
+			path := check.Field
+			if check.SummaryPath != "" {
+				path = check.SummaryPath
 			}
+			if reported[path] {
+				continue
+			}
+			reported[path] = true
+
+			msgCtx := dbMessageContext(check, data)
+			msgCtx.Path = path
+			msgCtx.Field = lastPathSegment(path)
+			msgCtx.Rule = "database"
+			errMsg := path + " could not be verified"
+			if opts.DBErrorMessage != nil {
+				errMsg = resolveMessage(opts.DBErrorMessage, msgCtx)
+			}
+			out.errs[path] = append(out.errs[path], errMsg)
 		}
 	}
+
+	return dbErr
+}
+
+// dbMessageContext builds the message context for a DB check
+func dbMessageContext(check DBCheck, data DataObject) MessageContext {
+	return MessageContext{
+		Field:  lastPathSegment(check.Field),
+		Path:   check.Field,
+		Index:  extractIndex(check.Field),
+		Value:  check.Value,
+		Data:   DataAccessor(data),
+		Table:  check.Rule.Table,
+		Column: check.Rule.Column,
+	}
+}
+
+// lastPathSegment returns the final segment of a dot-notation path, which is
+// the index for an array element (e.g., "0" for "ids.0")
+func lastPathSegment(path string) string {
+	if i := strings.LastIndexByte(path, '.'); i >= 0 {
+		return path[i+1:]
+	}
+	return path
 }
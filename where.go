@@ -0,0 +1,293 @@
+package valet
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// identifierRegex matches plain or schema-qualified SQL identifiers
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// whereOperators is the whitelist of operators accepted in where clauses
+var whereOperators = map[string]bool{
+	"=":           true,
+	"!=":          true,
+	"<>":          true,
+	"<":           true,
+	"<=":          true,
+	">":           true,
+	">=":          true,
+	"LIKE":        true,
+	"NOT LIKE":    true,
+	"IN":          true,
+	"NOT IN":      true,
+	"IS NULL":     true,
+	"IS NOT NULL": true,
+	"BETWEEN":     true,
+	"NOT BETWEEN": true,
+	"OR":          true,
+}
+
+// normalizeOperator upper-cases the operator and collapses inner whitespace
+func normalizeOperator(op string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(op), " "))
+}
+
+// validateIdentifier rejects table/column names that are not plain identifiers
+func validateIdentifier(name string) error {
+	if !identifierRegex.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidIdentifier, name)
+	}
+	return nil
+}
+
+// Validate checks that the clause only uses a whitelisted operator, a safe
+// column identifier and a value shape matching the operator
+func (w WhereClause) Validate() error {
+	op := normalizeOperator(w.Operator)
+	if !whereOperators[op] {
+		return fmt.Errorf("%w: %q", ErrInvalidOperator, w.Operator)
+	}
+
+	if op == "OR" {
+		if len(w.Or) == 0 {
+			return fmt.Errorf("%w: OR group has no clauses", ErrInvalidOperator)
+		}
+		for _, sub := range w.Or {
+			if err := sub.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := validateIdentifier(w.Column); err != nil {
+		return err
+	}
+
+	switch op {
+	case "IN", "NOT IN":
+		if _, ok := toAnySlice(w.Value); !ok {
+			return fmt.Errorf("%w: %s on %q requires a slice value", ErrInvalidOperator, op, w.Column)
+		}
+	case "BETWEEN", "NOT BETWEEN":
+		if vals, ok := toAnySlice(w.Value); !ok || len(vals) != 2 {
+			return fmt.Errorf("%w: %s on %q requires exactly two values", ErrInvalidOperator, op, w.Column)
+		}
+	}
+
+	return nil
+}
+
+// validateExistsQuery validates every identifier and clause used by a query
+func validateExistsQuery(table, column string, wheres []WhereClause) error {
+	if err := validateIdentifier(table); err != nil {
+		return err
+	}
+	if err := validateIdentifier(column); err != nil {
+		return err
+	}
+	for _, w := range wheres {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// whereArgCount returns the number of bind parameters a clause consumes
+func whereArgCount(w WhereClause) int {
+	switch normalizeOperator(w.Operator) {
+	case "IS NULL", "IS NOT NULL":
+		return 0
+	case "IN", "NOT IN":
+		vals, _ := toAnySlice(w.Value)
+		return len(vals)
+	case "BETWEEN", "NOT BETWEEN":
+		return 2
+	case "OR":
+		n := 0
+		for _, sub := range w.Or {
+			n += whereArgCount(sub)
+		}
+		return n
+	}
+	return 1
+}
+
+// renderWhere appends a validated clause to the query, numbering placeholders
+// after the arguments already collected
+func renderWhere(d Dialect, query *strings.Builder, w WhereClause, args []any) []any {
+	op := normalizeOperator(w.Operator)
+
+	if op == "OR" {
+		query.WriteByte('(')
+		for i, sub := range w.Or {
+			if i > 0 {
+				query.WriteString(" OR ")
+			}
+			args = renderWhere(d, query, sub, args)
+		}
+		query.WriteByte(')')
+		return args
+	}
+
+	col := d.QuoteIdentifier(w.Column)
+
+	switch op {
+	case "IS NULL", "IS NOT NULL":
+		query.WriteString(col)
+		query.WriteString(" ")
+		query.WriteString(op)
+	case "IN", "NOT IN":
+		vals, _ := toAnySlice(w.Value)
+		if len(vals) == 0 {
+			// Empty IN matches nothing, empty NOT IN matches everything
+			if op == "IN" {
+				query.WriteString("1=0")
+			} else {
+				query.WriteString("1=1")
+			}
+			return args
+		}
+		query.WriteString(col)
+		query.WriteString(" ")
+		query.WriteString(op)
+		query.WriteString(" (")
+		for i, v := range vals {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteString(d.Placeholder(len(args) + 1))
+			args = append(args, v)
+		}
+		query.WriteByte(')')
+	case "BETWEEN", "NOT BETWEEN":
+		vals, _ := toAnySlice(w.Value)
+		query.WriteString(col)
+		query.WriteString(" ")
+		query.WriteString(op)
+		query.WriteString(" ")
+		query.WriteString(d.Placeholder(len(args) + 1))
+		args = append(args, vals[0])
+		query.WriteString(" AND ")
+		query.WriteString(d.Placeholder(len(args) + 1))
+		args = append(args, vals[1])
+	default:
+		query.WriteString(col)
+		query.WriteString(" ")
+		query.WriteString(op)
+		query.WriteString(" ")
+		query.WriteString(d.Placeholder(len(args) + 1))
+		args = append(args, w.Value)
+	}
+
+	return args
+}
+
+// writeWhereKey appends a stable representation of a clause to a batch key so
+// checks only share a query when their clauses are identical
+func writeWhereKey(sb *strings.Builder, w WhereClause) {
+	op := normalizeOperator(w.Operator)
+	if op == "OR" {
+		sb.WriteString("(")
+		for i, sub := range w.Or {
+			if i > 0 {
+				sb.WriteString("|")
+			}
+			writeWhereKey(sb, sub)
+		}
+		sb.WriteString(")")
+		return
+	}
+
+	sb.WriteString(w.Column)
+	sb.WriteByte(' ')
+	sb.WriteString(op)
+	switch op {
+	case "IS NULL", "IS NOT NULL":
+		return
+	}
+	if w.Field != "" {
+		sb.WriteString(" @")
+		sb.WriteString(w.Field)
+		return
+	}
+	fmt.Fprintf(sb, " %T:%v", w.Value, w.Value)
+}
+
+// hasWhereFields reports whether any clause references a payload field
+func hasWhereFields(wheres []WhereClause) bool {
+	for _, w := range wheres {
+		if w.Field != "" || hasWhereFields(w.Or) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveWhereFields returns a copy of wheres with field references replaced
+// by their payload values, relative to the field being checked
+func resolveWhereFields(wheres []WhereClause, checkPath string, data DataObject) []WhereClause {
+	resolved := make([]WhereClause, len(wheres))
+	for i, w := range wheres {
+		if len(w.Or) > 0 {
+			w.Or = resolveWhereFields(w.Or, checkPath, data)
+		}
+		if w.Field != "" {
+			w.Value = lookupPath(data, resolveFieldRef(w.Field, checkPath)).Value()
+			w.Field = ""
+		}
+		resolved[i] = w
+	}
+	return resolved
+}
+
+// resolveFieldRef turns a relative or wildcard field reference into an
+// absolute path using the path of the field being checked
+func resolveFieldRef(ref, checkPath string) string {
+	checkParts := strings.Split(checkPath, ".")
+
+	if strings.HasPrefix(ref, ".") {
+		rest := strings.TrimLeft(ref, ".")
+		up := len(ref) - len(rest)
+		if up > len(checkParts) {
+			up = len(checkParts)
+		}
+		base := checkParts[:len(checkParts)-up]
+		if len(base) == 0 {
+			return rest
+		}
+		return strings.Join(base, ".") + "." + rest
+	}
+
+	if !strings.Contains(ref, "*") {
+		return ref
+	}
+
+	refParts := strings.Split(ref, ".")
+	for i, part := range refParts {
+		if part == "*" && i < len(checkParts) {
+			refParts[i] = checkParts[i]
+		}
+	}
+	return strings.Join(refParts, ".")
+}
+
+// toAnySlice converts any slice or array value to []any
+func toAnySlice(value any) ([]any, bool) {
+	if vals, ok := value.([]any); ok {
+		return vals, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	vals := make([]any, rv.Len())
+	for i := range vals {
+		vals[i] = rv.Index(i).Interface()
+	}
+	return vals, true
+}
@@ -0,0 +1,295 @@
+package valet
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWhereClauseValidate(t *testing.T) {
+	valid := []WhereClause{
+		WhereEq("status", "active"),
+		Where("stock", ">=", 1),
+		Where("name", "not  like", "%x%"),
+		WhereIn("role", "admin", "editor"),
+		WhereNotIn("role", "banned"),
+		WhereNull("deleted_at"),
+		WhereNotNull("verified_at"),
+		WhereLike("email", "%@example.com"),
+		WhereBetween("age", 18, 65),
+		WhereOr(WhereEq("a", 1), WhereNull("b")),
+		Where("role", "in", []string{"a", "b"}),
+		WhereEq("users.status", "active"),
+	}
+	for _, w := range valid {
+		if err := w.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %v", w, err)
+		}
+	}
+
+	invalidOps := []WhereClause{
+		Where("status", "= 1 OR 1=1 --", "x"),
+		Where("status", ";", "x"),
+		Where("status", "", "x"),
+		Where("role", "IN", "admin"),
+		Where("age", "BETWEEN", []any{1}),
+		WhereOr(),
+		WhereOr(Where("a", "== ", 1)),
+	}
+	for _, w := range invalidOps {
+		if err := w.Validate(); !errors.Is(err, ErrInvalidOperator) {
+			t.Errorf("Expected ErrInvalidOperator for %+v, got %v", w, err)
+		}
+	}
+
+	invalidColumns := []WhereClause{
+		WhereEq("status; DROP TABLE users", 1),
+		WhereEq("1=1 OR status", 1),
+		WhereEq("", 1),
+		WhereNull("a.b.c"),
+		WhereOr(WhereEq("ok", 1), WhereEq("bad col", 2)),
+	}
+	for _, w := range invalidColumns {
+		if err := w.Validate(); !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("Expected ErrInvalidIdentifier for %+v, got %v", w, err)
+		}
+	}
+}
+
+func TestBuildExistsQuery_Operators(t *testing.T) {
+	tests := []struct {
+		name      string
+		where     WhereClause
+		wantWhere string
+		wantArgs  int
+	}{
+		{"in", WhereIn("role", "a", "b"), `"role" IN ($2,$3)`, 3},
+		{"not in", WhereNotIn("role", "x"), `"role" NOT IN ($2)`, 2},
+		{"empty in", WhereIn("role"), `1=0`, 1},
+		{"empty not in", WhereNotIn("role"), `1=1`, 1},
+		{"null", WhereNull("deleted_at"), `"deleted_at" IS NULL`, 1},
+		{"not null", WhereNotNull("verified_at"), `"verified_at" IS NOT NULL`, 1},
+		{"like", WhereLike("email", "%@x.com"), `"email" LIKE $2`, 2},
+		{"between", WhereBetween("age", 18, 65), `"age" BETWEEN $2 AND $3`, 3},
+		{"lowercase operator", Where("name", "not like", "a%"), `"name" NOT LIKE $2`, 2},
+		{"or group", WhereOr(WhereEq("a", 1), WhereNull("b"), WhereIn("c", 2, 3)), `("a" = $2 OR "b" IS NULL OR "c" IN ($3,$4))`, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries, err := buildExistsQueries(PostgresDialect{}, "users", "id", []any{1}, []WhereClause{tt.where})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			want := `SELECT "id" FROM "users" WHERE "id" IN ($1) AND ` + tt.wantWhere
+			if queries[0].SQL != want {
+				t.Errorf("Query = %s, want %s", queries[0].SQL, want)
+			}
+			if len(queries[0].Args) != tt.wantArgs {
+				t.Errorf("Args = %v, want %d args", queries[0].Args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestBuildExistsQuery_RejectsUnsafeInput(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		_, _, err := buildExistsQuery("users; DROP TABLE users", "id", []any{1}, nil)
+		if !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("Expected ErrInvalidIdentifier, got %v", err)
+		}
+	})
+
+	t.Run("column", func(t *testing.T) {
+		_, _, err := buildExistsQuery("users", "id) OR (1=1", []any{1}, nil)
+		if !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("Expected ErrInvalidIdentifier, got %v", err)
+		}
+	})
+
+	t.Run("operator", func(t *testing.T) {
+		_, _, err := buildExistsQuery("users", "id", []any{1}, []WhereClause{Where("status", "= 'x' OR 1=1 --", nil)})
+		if !errors.Is(err, ErrInvalidOperator) {
+			t.Errorf("Expected ErrInvalidOperator, got %v", err)
+		}
+	})
+
+	t.Run("adapter returns error without querying", func(t *testing.T) {
+		db := &recordingSQLX{}
+		_, err := NewSQLXAdapter(db).CheckExists(context.Background(), "users", "id", []any{1}, []WhereClause{WhereEq("bad col", 1)})
+		if !errors.Is(err, ErrInvalidIdentifier) {
+			t.Errorf("Expected ErrInvalidIdentifier, got %v", err)
+		}
+		if len(db.queries) != 0 {
+			t.Error("Adapter should not query with unsafe input")
+		}
+	})
+}
+
+func TestBuildExistsQueries_ChunksWithWhereArgs(t *testing.T) {
+	values := make([]any, 1000)
+	for i := range values {
+		values[i] = i
+	}
+	wheres := []WhereClause{WhereIn("status", "a", "b", "c"), WhereBetween("age", 1, 2)}
+
+	queries, err := buildExistsQueries(SQLiteDialect{}, "items", "id", values, wheres)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(queries) != 2 {
+		t.Fatalf("Expected 2 chunks, got %d", len(queries))
+	}
+	for _, q := range queries {
+		if len(q.Args) > 999 {
+			t.Errorf("Chunk has %d args, exceeds limit", len(q.Args))
+		}
+	}
+}
+
+func TestMakeBatchKey_Wheres(t *testing.T) {
+	a := makeBatchKey("users", "id", []WhereClause{WhereEq("status", "active")})
+	b := makeBatchKey("users", "id", []WhereClause{WhereEq("status", "banned")})
+	if a == b {
+		t.Error("Clauses with different values must not share a batch key")
+	}
+
+	c := makeBatchKey("users", "id", []WhereClause{WhereIn("role", "a", "b")})
+	d := makeBatchKey("users", "id", []WhereClause{WhereIn("role", "a", "b")})
+	if c != d {
+		t.Error("Identical clauses should share a batch key")
+	}
+
+	e := makeBatchKey("users", "id", []WhereClause{WhereOr(WhereNull("a"), WhereEq("b", 1))})
+	f := makeBatchKey("users", "id", []WhereClause{WhereOr(WhereNull("a"), WhereEq("b", 2))})
+	if e == f {
+		t.Error("OR groups with different values must not share a batch key")
+	}
+
+	g := makeBatchKey("users", "id", []WhereClause{WhereEq("n", 1)})
+	h := makeBatchKey("users", "id", []WhereClause{WhereEq("n", "1")})
+	if g == h {
+		t.Error("Values of different types must not share a batch key")
+	}
+}
+
+func TestValidate_WhereGroupsBatchSeparately(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		var sb strings.Builder
+		for _, w := range wheres {
+			writeWhereKey(&sb, w)
+		}
+		mu.Lock()
+		calls = append(calls, sb.String())
+		mu.Unlock()
+		return map[any]bool{"x": wheres[0].Value == "active"}, nil
+	})
+
+	schema := Schema{
+		"a": String().Exists("users", "name", WhereEq("status", "active")),
+		"b": String().Exists("users", "name", WhereEq("status", "banned")),
+	}
+
+	err := ValidateWithDB(context.Background(), DataObject{"a": "x", "b": "x"}, schema, checker)
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 separate queries, got %d", len(calls))
+	}
+	if err == nil || len(err.Errors["b"]) == 0 || len(err.Errors["a"]) != 0 {
+		t.Errorf("Expected only b to fail, got %v", err)
+	}
+}
+
+func TestResolveFieldRef(t *testing.T) {
+	tests := []struct {
+		ref, checkPath, want string
+	}{
+		{"country_id", "city_id", "country_id"},
+		{"country_id", "addresses.2.city_id", "country_id"},
+		{".country_id", "addresses.2.city_id", "addresses.2.country_id"},
+		{"..owner_id", "addresses.2.city_id", "addresses.owner_id"},
+		{".country_id", "city_id", "country_id"},
+		{"addresses.*.country_id", "addresses.2.city_id", "addresses.2.country_id"},
+		{"orders.*.lines.*.sku", "orders.1.lines.4.qty", "orders.1.lines.4.sku"},
+	}
+
+	for _, tt := range tests {
+		if got := resolveFieldRef(tt.ref, tt.checkPath); got != tt.want {
+			t.Errorf("resolveFieldRef(%q, %q) = %q, want %q", tt.ref, tt.checkPath, got, tt.want)
+		}
+	}
+}
+
+func TestWhereField_Validation(t *testing.T) {
+	// cities: (id, country_id)
+	cities := map[float64]float64{1: 10, 2: 10, 3: 20}
+
+	var mu sync.Mutex
+	var calls [][]any
+	checker := FuncAdapter(func(ctx context.Context, table, column string, values []any, wheres []WhereClause) (map[any]bool, error) {
+		mu.Lock()
+		calls = append(calls, values)
+		mu.Unlock()
+
+		country := wheres[0].Value
+		result := make(map[any]bool)
+		for _, v := range values {
+			id, _ := v.(float64)
+			if c, ok := cities[id]; ok && c == country {
+				result[v] = true
+			}
+		}
+		return result, nil
+	})
+
+	t.Run("top-level field", func(t *testing.T) {
+		calls = nil
+		schema := Schema{
+			"country_id": Float().Required(),
+			"city_id":    Float().Required().Exists("cities", "id", WhereField("country_id", "=", "country_id")),
+		}
+
+		if err := ValidateWithDB(context.Background(), DataObject{"country_id": float64(10), "city_id": float64(1)}, schema, checker); err != nil {
+			t.Errorf("Expected no errors, got %v", err.Errors)
+		}
+
+		err := ValidateWithDB(context.Background(), DataObject{"country_id": float64(10), "city_id": float64(3)}, schema, checker)
+		if err == nil || len(err.Errors["city_id"]) == 0 {
+			t.Error("Expected city_id error for city in another country")
+		}
+	})
+
+	t.Run("relative path in array elements batches by resolved value", func(t *testing.T) {
+		calls = nil
+		schema := Schema{
+			"addresses": Array().Of(Object().Shape(Schema{
+				"country_id": Float().Required(),
+				"city_id":    Float().Required().Exists("cities", "id", WhereField("country_id", "=", ".country_id")),
+			})),
+		}
+		data := DataObject{
+			"addresses": []any{
+				map[string]any{"country_id": float64(10), "city_id": float64(1)},
+				map[string]any{"country_id": float64(20), "city_id": float64(3)},
+				map[string]any{"country_id": float64(10), "city_id": float64(2)},
+				map[string]any{"country_id": float64(20), "city_id": float64(2)},
+			},
+		}
+
+		err := ValidateWithDB(context.Background(), data, schema, checker)
+		if err == nil {
+			t.Fatal("Expected error for addresses.3.city_id")
+		}
+		if len(err.Errors) != 1 || len(err.Errors["addresses.3.city_id"]) == 0 {
+			t.Errorf("Expected only addresses.3.city_id to fail, got %v", err.Errors)
+		}
+		if len(calls) != 2 {
+			t.Errorf("Expected 2 batched queries (one per country), got %d", len(calls))
+		}
+	})
+}
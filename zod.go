@@ -0,0 +1,734 @@
+package valet
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ToZod generates a TypeScript module with a Zod schema and inferred type for
+// each named schema: "User" becomes `export const UserSchema = z.object(...)`
+// and `export type User = z.infer<typeof UserSchema>`. Formats, lengths,
+// bounds, patterns, nested shapes (strict or passthrough), Enum, Literal,
+// Union, Optional, nullable fields and string messages are generated; rules
+// that only the server can check (DB rules, custom functions, cross-field
+// rules, MessageFunc messages) are listed in "// server-only:" comments.
+func ToZod(schemas map[string]Schema) string {
+	var sb strings.Builder
+	sb.WriteString("import { z } from \"zod\";\n")
+	for _, name := range sortedSchemaNames(schemas) {
+		x := &zodExporter{}
+		expr := x.object(DescribeSchema(schemas[name]), false, "")
+		fmt.Fprintf(&sb, "\nexport const %sSchema = %s;\n", name, expr)
+		fmt.Fprintf(&sb, "export type %s = z.infer<typeof %sSchema>;\n", name, name)
+	}
+	return sb.String()
+}
+
+// ToTypeScript generates a TypeScript interface for each named schema.
+// Fields that are not required are optional and accept null, as valet treats
+// null and absent values alike.
+func ToTypeScript(schemas map[string]Schema) string {
+	var sb strings.Builder
+	for i, name := range sortedSchemaNames(schemas) {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "export interface %s %s\n", name, tsObject(DescribeSchema(schemas[name]), ""))
+	}
+	return sb.String()
+}
+
+func sortedSchemaNames(schemas map[string]Schema) []string {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedFieldNames(fields map[string]Description) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tsIdentifier matches property names that need no quotes
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// tsKey quotes property names that are not identifiers
+func tsKey(name string) string {
+	if tsIdentifier.MatchString(name) {
+		return name
+	}
+	return jsValue(name)
+}
+
+// jsValue formats a Go value as a JavaScript literal
+func jsValue(v any) string {
+	if t, ok := v.(time.Time); ok {
+		v = t.Format(time.RFC3339)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "undefined"
+	}
+	return string(b)
+}
+
+// jsList formats a slice parameter as a JavaScript array literal
+func jsList(v any) string {
+	values := anySlice(v)
+	items := make([]string, len(values))
+	for i, item := range values {
+		items[i] = jsValue(item)
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+// goFlagsPrefix matches a leading flag group such as (?i)
+var goFlagsPrefix = regexp.MustCompile(`^\(\?([a-zA-Z]+)\)`)
+
+// jsRegex converts a Go pattern to a JavaScript regex literal. It returns ""
+// for Go-only syntax.
+func jsRegex(pattern string) string {
+	var flags string
+	if m := goFlagsPrefix.FindStringSubmatch(pattern); m != nil {
+		for _, f := range m[1] {
+			if f != 'i' && f != 'm' && f != 's' {
+				return ""
+			}
+		}
+		flags = m[1]
+		pattern = pattern[len(m[0]):]
+	}
+	for _, goOnly := range []string{`\A`, `\z`, `\Q`, `[[:`, `(?P<`, `(?U`} {
+		if strings.Contains(pattern, goOnly) {
+			return ""
+		}
+	}
+
+	// Unicode classes and \x{...} need the u flag, which also rejects
+	// escaped punctuation JavaScript does not treat as syntax (e.g. \#)
+	unicodeMode := false
+	for i := 0; i+1 < len(pattern); i++ {
+		if pattern[i] == '\\' {
+			next := pattern[i+1]
+			unicodeMode = unicodeMode || next == 'p' || next == 'P' || next == 'x' && strings.HasPrefix(pattern[i+2:], "{")
+			i++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('/')
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			e := pattern[i]
+			switch {
+			case e == 'p' || e == 'P':
+				class, n := jsUnicodeClass(pattern[i+1:], e == 'P')
+				if class == "" {
+					return ""
+				}
+				sb.WriteString(class)
+				i += n
+			case e == 'x' && strings.HasPrefix(pattern[i+1:], "{"):
+				end := strings.IndexByte(pattern[i:], '}')
+				if end < 0 {
+					return ""
+				}
+				sb.WriteString(`\u` + pattern[i+1:i+end+1])
+				i += end
+			case unicodeMode && e < utf8.RuneSelf && unicode.IsPunct(rune(e)) && !strings.ContainsRune(`^$\.*+?()[]{}|/`, rune(e)) && !(inClass && e == '-'):
+				sb.WriteByte(e)
+			default:
+				sb.WriteByte('\\')
+				sb.WriteByte(e)
+			}
+		case c == '[' && !inClass:
+			inClass = true
+			sb.WriteByte(c)
+		case c == ']' && inClass:
+			inClass = false
+			sb.WriteByte(c)
+		case c == '/':
+			sb.WriteString(`\/`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('/')
+	if unicodeMode {
+		flags += "u"
+	}
+	return sb.String() + flags
+}
+
+// jsUnicodeClass converts the rest of a Go \p or \P class (L, {Lu}, {Greek},
+// {^Greek}) to JavaScript syntax, returning the bytes consumed. Scripts
+// become Script= properties.
+func jsUnicodeClass(rest string, negated bool) (string, int) {
+	var name string
+	n := 0
+	switch {
+	case strings.HasPrefix(rest, "{"):
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return "", 0
+		}
+		name, n = rest[1:end], end+1
+	case rest != "":
+		name, n = rest[:1], 1
+	default:
+		return "", 0
+	}
+	if strings.HasPrefix(name, "^") {
+		name, negated = name[1:], !negated
+	}
+	if name == "" {
+		return "", 0
+	}
+
+	prefix := `\p`
+	if negated {
+		prefix = `\P`
+	}
+	if _, ok := unicode.Categories[name]; ok || name == "Any" {
+		return prefix + "{" + name + "}", n
+	}
+	if _, ok := unicode.Scripts[name]; ok {
+		return prefix + "{Script=" + name + "}", n
+	}
+	return "", 0
+}
+
+// zodExporter converts descriptions to Zod expressions, collecting the
+// server-only rules of the current field
+type zodExporter struct {
+	notes []string
+}
+
+// serverOnly records a rule the generated schema cannot check
+func (x *zodExporter) serverOnly(r RuleDescription) {
+	note := r.Name
+	switch p := r.Param.(type) {
+	case nil:
+	case string:
+		note += " " + p
+	default:
+		note += " " + jsValue(p)
+	}
+	x.notes = append(x.notes, note)
+}
+
+// message returns the Zod message option for a rule, or "" for the default
+func (x *zodExporter) message(d Description, rule string) string {
+	switch m := d.Messages[rule].(type) {
+	case string:
+		return "{ message: " + jsValue(m) + " }"
+	case nil:
+		return ""
+	}
+	x.notes = append(x.notes, rule+" message")
+	return ""
+}
+
+// args joins call arguments, dropping empty ones
+func args(values ...string) string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return strings.Join(out, ", ")
+}
+
+// object converts an object shape, one field per line
+func (x *zodExporter) object(fields map[string]Description, strict bool, indent string) string {
+	if len(fields) == 0 {
+		return "z.object({})" + objectMode(strict)
+	}
+	var sb strings.Builder
+	sb.WriteString("z.object({\n")
+	for _, name := range sortedFieldNames(fields) {
+		field := &zodExporter{}
+		expr := field.field(fields[name], indent+"  ") + presence(fields[name])
+		fmt.Fprintf(&sb, "%s  %s: %s,", indent, tsKey(name), expr)
+		if len(field.notes) > 0 {
+			sb.WriteString(" // server-only: " + strings.Join(field.notes, ", "))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString(indent + "})" + objectMode(strict))
+	return sb.String()
+}
+
+func objectMode(strict bool) string {
+	if strict {
+		return ".strict()"
+	}
+	return ".passthrough()"
+}
+
+// field converts a single field description
+func (x *zodExporter) field(d Description, indent string) string {
+	var expr string
+	switch d.Type {
+	case "string":
+		expr = x.string(d)
+	case "number", "integer":
+		expr = x.number(d)
+	case "boolean":
+		expr = x.boolean(d)
+	case "array":
+		expr = x.array(d, indent)
+	case "object":
+		strict := false
+		for _, r := range d.Rules {
+			if r.Name == "strict" {
+				strict = true
+			} else {
+				x.serverOnly(r)
+			}
+		}
+		expr = x.object(d.Fields, strict, indent)
+	case "time":
+		expr = x.time(d)
+	case "file":
+		expr = x.file(d)
+	case "enum", "literal":
+		var values []any
+		for _, r := range d.Rules {
+			if r.Name == d.Type {
+				values = anySlice(r.Param)
+			} else {
+				x.serverOnly(r)
+			}
+		}
+		expr = zodEnum(values)
+	case "union":
+		variants := make([]string, len(d.Variants))
+		for i, v := range d.Variants {
+			variants[i] = x.field(v, indent)
+		}
+		expr = "z.union([" + strings.Join(variants, ", ") + "])"
+		for _, r := range d.Rules {
+			if r.Name != "union" {
+				x.serverOnly(r)
+			}
+		}
+	case "any":
+		expr = "z.any()"
+		for _, r := range d.Rules {
+			x.serverOnly(r)
+		}
+	default: // custom
+		expr = "z.unknown()"
+		x.notes = append(x.notes, "custom validator")
+	}
+
+	for _, db := range d.DB {
+		switch {
+		case db.Func:
+			x.notes = append(x.notes, db.Rule+" func")
+		case db.Query != nil:
+			x.notes = append(x.notes, db.Rule+" query")
+		default:
+			x.notes = append(x.notes, db.Rule+" "+db.Table+"."+db.Column)
+		}
+	}
+
+	return expr
+}
+
+// presence returns the modifiers for an object field that is not required,
+// nullable or has a default
+func presence(d Description) string {
+	var out string
+	if d.Type == "string" && !d.Required && d.Default == nil && len(d.Rules) > 0 {
+		// Optional strings may be empty
+		out = `.or(z.literal(""))`
+	}
+	switch {
+	case d.Default != nil:
+		out += ".default(" + jsValue(d.Default) + ")"
+		if d.Nullable {
+			out += ".nullable()"
+		}
+	case !d.Required || d.Nullable || d.Optional:
+		// valet treats null like an absent value
+		out += ".nullish()"
+	}
+	return out
+}
+
+// zodEnum converts Enum and Literal values
+func zodEnum(values []any) string {
+	allStrings := len(values) > 0
+	for _, v := range values {
+		if _, ok := v.(string); !ok {
+			allStrings = false
+		}
+	}
+	switch {
+	case len(values) == 1:
+		return "z.literal(" + jsValue(values[0]) + ")"
+	case allStrings:
+		return "z.enum(" + jsList(values) + ")"
+	}
+	literals := make([]string, len(values))
+	for i, v := range values {
+		literals[i] = "z.literal(" + jsValue(v) + ")"
+	}
+	return "z.union([" + strings.Join(literals, ", ") + "])"
+}
+
+// stringRegexRules are string rules checked with a fixed pattern
+var stringRegexRules = map[string]string{
+	"alpha":        alphaRegex.String(),
+	"alphaNumeric": alphaNumericRegex.String(),
+	"alphaDash":    alphaDashRegex.String(),
+	"hexColor":     hexColorRegex.String(),
+	"mac":          macRegex.String(),
+	"ascii":        `^[\x00-\x7F]*$`,
+}
+
+// string converts string rules
+func (x *zodExporter) string(d Description) string {
+	var sb strings.Builder
+	sb.WriteString("z.string(" + x.typeMessages(d) + ")")
+	for _, r := range d.Rules {
+		msg := func() string { return x.message(d, r.Name) }
+		if pattern, ok := stringRegexRules[r.Name]; ok {
+			sb.WriteString(".regex(" + args(jsRegex(pattern), msg()) + ")")
+			continue
+		}
+		switch r.Name {
+		case "trim":
+			sb.WriteString(".trim()")
+		case "lowercase":
+			sb.WriteString(".toLowerCase()")
+		case "uppercase":
+			sb.WriteString(".toUpperCase()")
+		case "min", "max", "length":
+			fmt.Fprintf(&sb, ".%s(%s)", r.Name, args(jsValue(r.Param), msg()))
+		case "email", "url", "uuid", "ulid":
+			fmt.Fprintf(&sb, ".%s(%s)", r.Name, msg())
+		case "ip":
+			fmt.Fprintf(&sb, ".ip(%s)", msg())
+		case "ipv4", "ipv6":
+			opts := `{ version: "v` + r.Name[3:] + `"`
+			if msg() != "" {
+				opts += ", message: " + jsValue(d.Messages[r.Name])
+			}
+			fmt.Fprintf(&sb, ".ip(%s })", opts)
+		case "base64":
+			fmt.Fprintf(&sb, ".base64(%s)", msg())
+		case "startsWith", "endsWith":
+			fmt.Fprintf(&sb, ".%s(%s)", r.Name, args(jsValue(r.Param), msg()))
+		case "contains":
+			fmt.Fprintf(&sb, ".includes(%s)", args(jsValue(r.Param), msg()))
+		case "includes":
+			m := msg()
+			for _, s := range anySlice(r.Param) {
+				fmt.Fprintf(&sb, ".includes(%s)", args(jsValue(s), m))
+			}
+		case "digits":
+			fmt.Fprintf(&sb, ".regex(%s)", args(fmt.Sprintf("/^[0-9]{%d}$/", r.Param), msg()))
+		case "regex", "notRegex":
+			re := jsRegex(r.Param.(string))
+			switch {
+			case re == "":
+				x.serverOnly(r)
+			case r.Name == "regex":
+				fmt.Fprintf(&sb, ".regex(%s)", args(re, msg()))
+			default:
+				fmt.Fprintf(&sb, ".refine(%s)", args("(v) => !"+re+".test(v)", msg()))
+			}
+		case "in":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => "+jsList(r.Param)+".includes(v)", msg()))
+		case "notIn":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => !"+jsList(r.Param)+".includes(v)", msg()))
+		case "doesntStartWith", "doesntEndWith":
+			method := "startsWith"
+			if r.Name == "doesntEndWith" {
+				method = "endsWith"
+			}
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => !"+jsList(r.Param)+".some((s) => v."+method+"(s))", msg()))
+		case "json":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => { try { JSON.parse(v); return true; } catch { return false; } }", msg()))
+		default: // requiredIf, requiredUnless, transform, sameAs, differentFrom, custom
+			x.serverOnly(r)
+		}
+	}
+	if d.Required && d.Default == nil {
+		// valet rejects empty required strings
+		sb.WriteString(".min(" + args("1", x.message(d, "required")) + ")")
+	}
+	return sb.String()
+}
+
+// typeMessages returns the Zod schema options for the required and type
+// messages
+func (x *zodExporter) typeMessages(d Description) string {
+	var opts []string
+	if m, ok := d.Messages["required"].(string); ok && d.Required {
+		opts = append(opts, "required_error: "+jsValue(m))
+	}
+	if m, ok := d.Messages["type"]; ok {
+		if s, ok := m.(string); ok {
+			opts = append(opts, "invalid_type_error: "+jsValue(s))
+		} else {
+			x.notes = append(x.notes, "type message")
+		}
+	}
+	if len(opts) == 0 {
+		return ""
+	}
+	return "{ " + strings.Join(opts, ", ") + " }"
+}
+
+// number converts number rules
+func (x *zodExporter) number(d Description) string {
+	var sb strings.Builder
+	base := "z.number("
+	if _, ok := d.Rule("coerce"); ok {
+		base = "z.coerce.number("
+	}
+	sb.WriteString(base + x.typeMessages(d) + ")")
+	if d.Type == "integer" {
+		sb.WriteString(".int()")
+	}
+	for _, r := range d.Rules {
+		msg := func() string { return x.message(d, r.Name) }
+		switch r.Name {
+		case "coerce":
+		case "integer":
+			if d.Type != "integer" {
+				fmt.Fprintf(&sb, ".int(%s)", msg())
+			}
+		case "min", "max", "multipleOf":
+			fmt.Fprintf(&sb, ".%s(%s)", r.Name, args(jsValue(r.Param), msg()))
+		case "positive", "negative":
+			fmt.Fprintf(&sb, ".%s(%s)", r.Name, msg())
+		case "in":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => "+jsList(r.Param)+".includes(v)", msg()))
+		case "notIn":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => !"+jsList(r.Param)+".includes(v)", msg()))
+		case "minDigits":
+			fmt.Fprintf(&sb, ".refine(%s)", args(fmt.Sprintf("(v) => String(Math.trunc(Math.abs(v))).length >= %d", r.Param), msg()))
+		case "maxDigits":
+			fmt.Fprintf(&sb, ".refine(%s)", args(fmt.Sprintf("(v) => String(Math.trunc(Math.abs(v))).length <= %d", r.Param), msg()))
+		default: // requiredIf, field comparisons, regex, custom
+			x.serverOnly(r)
+		}
+	}
+	return sb.String()
+}
+
+// boolean converts boolean rules
+func (x *zodExporter) boolean(d Description) string {
+	expr := "z.boolean(" + x.typeMessages(d) + ")"
+	for _, r := range d.Rules {
+		switch r.Name {
+		case "true", "false":
+			expr += ".refine(" + args("(v) => v === "+r.Name, x.message(d, r.Name)) + ")"
+		default: // coerce ("1", "yes", ...), requiredIf, custom
+			x.serverOnly(r)
+		}
+	}
+	return expr
+}
+
+// array converts array rules
+func (x *zodExporter) array(d Description, indent string) string {
+	elem := "z.unknown()"
+	if d.Element != nil {
+		elem = x.field(*d.Element, indent)
+		if d.Element.Nullable {
+			elem += ".nullable()"
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString("z.array(" + args(elem, x.typeMessages(d)) + ")")
+	for _, r := range d.Rules {
+		msg := func() string { return x.message(d, r.Name) }
+		switch r.Name {
+		case "min", "max", "length":
+			fmt.Fprintf(&sb, ".%s(%s)", r.Name, args(jsValue(r.Param), msg()))
+		case "unique":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => new Set(v).size === v.length", msg()))
+		case "contains":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => "+jsList(r.Param)+".every((c) => v.includes(c))", msg()))
+		case "doesntContain":
+			fmt.Fprintf(&sb, ".refine(%s)", args("(v) => !"+jsList(r.Param)+".some((c) => v.includes(c))", msg()))
+		default:
+			x.serverOnly(r)
+		}
+	}
+	return sb.String()
+}
+
+// time converts time rules; only ISO layouts are checked in the browser
+func (x *zodExporter) time(d Description) string {
+	expr := "z.string(" + x.typeMessages(d) + ")"
+	iso := false
+	for _, r := range d.Rules {
+		msg := func() string { return x.message(d, r.Name) }
+		switch r.Name {
+		case "format":
+			switch timeJSONFormat(r.Param) {
+			case "date-time":
+				opts := "{ offset: true"
+				if msg() != "" {
+					opts += ", message: " + jsValue(d.Messages["format"])
+				}
+				expr += ".datetime(" + opts + " })"
+				iso = true
+			case "date":
+				expr += ".date(" + msg() + ")"
+				iso = true
+			case "time":
+				expr += ".time(" + msg() + ")"
+			default:
+				x.serverOnly(r)
+			}
+		case "after", "before":
+			if !iso {
+				x.serverOnly(r)
+				continue
+			}
+			op := ">"
+			if r.Name == "before" {
+				op = "<"
+			}
+			expr += ".refine(" + args(fmt.Sprintf("(v) => new Date(v) %s new Date(%s)", op, jsValue(r.Param)), msg()) + ")"
+		default: // afterField, beforeField, between, requiredIf, custom
+			x.serverOnly(r)
+		}
+	}
+	return expr
+}
+
+// file converts file rules to checks on a browser File
+func (x *zodExporter) file(d Description) string {
+	expr := "z.instanceof(" + args("File", x.typeMessages(d)) + ")"
+	for _, r := range d.Rules {
+		msg := func() string { return x.message(d, r.Name) }
+		var check string
+		switch r.Name {
+		case "min":
+			check = fmt.Sprintf("(f) => f.size >= %v", r.Param)
+		case "max":
+			check = fmt.Sprintf("(f) => f.size <= %v", r.Param)
+		case "mimes":
+			check = "(f) => " + jsList(r.Param) + ".includes(f.type)"
+		case "extensions":
+			check = "(f) => " + jsList(r.Param) + ".includes(f.name.split(\".\").pop()?.toLowerCase() ?? \"\")"
+		case "image":
+			check = "(f) => f.type.startsWith(\"image/\")"
+		default: // dimensions, requiredIf, custom
+			x.serverOnly(r)
+			continue
+		}
+		expr += ".refine(" + args(check, msg()) + ")"
+	}
+	return expr
+}
+
+// tsObject converts an object shape to a TypeScript type literal
+func tsObject(fields map[string]Description, indent string) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, name := range sortedFieldNames(fields) {
+		d := fields[name]
+		typ := tsType(d, indent+"  ")
+		optional := ""
+		if !d.Required && d.Default == nil || d.Optional {
+			optional = "?"
+			typ += " | null"
+		} else if d.Nullable {
+			typ += " | null"
+		}
+		fmt.Fprintf(&sb, "%s  %s%s: %s;\n", indent, tsKey(name), optional, typ)
+	}
+	sb.WriteString(indent + "}")
+	return sb.String()
+}
+
+// tsType converts a description to a TypeScript type
+func tsType(d Description, indent string) string {
+	switch d.Type {
+	case "string":
+		if r, ok := d.Rule("in"); ok {
+			return tsUnion(anySlice(r.Param))
+		}
+		return "string"
+	case "number", "integer":
+		return "number"
+	case "boolean":
+		for _, r := range d.Rules {
+			if r.Name == "true" || r.Name == "false" {
+				return r.Name
+			}
+		}
+		return "boolean"
+	case "array":
+		if d.Element == nil {
+			return "unknown[]"
+		}
+		elem := tsType(*d.Element, indent)
+		if d.Element.Nullable || strings.Contains(elem, " | ") {
+			if d.Element.Nullable {
+				elem += " | null"
+			}
+			return "Array<" + elem + ">"
+		}
+		return elem + "[]"
+	case "object":
+		return tsObject(d.Fields, indent)
+	case "time":
+		return "string"
+	case "file":
+		return "File"
+	case "enum", "literal":
+		if r, ok := d.Rule(d.Type); ok {
+			return tsUnion(anySlice(r.Param))
+		}
+	case "union":
+		variants := make([]string, len(d.Variants))
+		for i, v := range d.Variants {
+			variants[i] = tsType(v, indent)
+		}
+		return strings.Join(variants, " | ")
+	case "any":
+		return "any"
+	}
+	return "unknown"
+}
+
+// tsUnion converts values to a union of literal types
+func tsUnion(values []any) string {
+	literals := make([]string, len(values))
+	for i, v := range values {
+		literals[i] = jsValue(v)
+	}
+	return strings.Join(literals, " | ")
+}
@@ -0,0 +1,135 @@
+package valet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToZod(t *testing.T) {
+	out := ToZod(map[string]Schema{
+		"User": {
+			"email":   String().Required().Email().Max(100).Message("email", "Invalid email").Unique("users", "email", nil),
+			"name":    String().Trim().Min(2),
+			"age":     Int().Nullable().Min(18),
+			"role":    Enum("admin", "user").Default("user"),
+			"kind":    Literal("post"),
+			"ref":     Union(String().UUID(), Int()),
+			"site":    Optional(String().URL()),
+			"tags":    Array().Max(5).Unique().Of(String().Regex(`(?i)^[a-z/]+$`)),
+			"address": Object().Strict().Shape(Schema{"city": String().Required(), "zip-code": String().Custom(func(string, Lookup) error { return nil })}),
+			"confirm": String().Required().SameAs("password"),
+			"born":    Time().Format("2006-01-02").After(mustParseDate("1900-01-01")),
+			"terms":   Bool().Required().True(),
+		},
+		"Empty": {},
+	})
+
+	for _, want := range []string{
+		`import { z } from "zod";`,
+		"export const EmptySchema = z.object({}).passthrough();",
+		"export const UserSchema = z.object({\n",
+		"export type User = z.infer<typeof UserSchema>;",
+		`  email: z.string().max(100).email({ message: "Invalid email" }).min(1), // server-only: unique users.email`,
+		`  name: z.string().trim().min(2).or(z.literal("")).nullish(),`,
+		"  age: z.number().int().min(18).nullish(),",
+		`  role: z.enum(["admin", "user"]).default("user"),`,
+		`  kind: z.literal("post").nullish(),`,
+		"  ref: z.union([z.string().uuid(), z.number().int()]).nullish(),",
+		`  site: z.string().url().or(z.literal("")).nullish(),`,
+		"  tags: z.array(z.string().regex(/^[a-z\\/]+$/i)).max(5).refine((v) => new Set(v).size === v.length).nullish(),",
+		"    city: z.string().min(1),",
+		`    "zip-code": z.string().or(z.literal("")).nullish(), // server-only: custom`,
+		"  }).strict().nullish(),",
+		"  confirm: z.string().min(1), // server-only: sameAs password",
+		`  born: z.string().date().refine((v) => new Date(v) > new Date("1900-01-01")).nullish(),`,
+		"  terms: z.boolean().refine((v) => v === true),",
+		"}).passthrough();",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Missing %q in:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "EmptySchema") > strings.Index(out, "UserSchema") {
+		t.Errorf("Expected schemas sorted by name:\n%s", out)
+	}
+}
+
+func TestToZod_ServerOnly(t *testing.T) {
+	out := ToZod(map[string]Schema{"Order": {
+		"id":     Int().Required().Exists("orders", "id"),
+		"qty":    Int().GreaterThan("min_qty"),
+		"code":   String().Regex(`\A[a-z]+\z`),
+		"note":   String().Required(MessageFunc(func(MessageContext) string { return "x" })),
+		"custom": customValidator{},
+		"slug":   String().Regex(`\A[a-z-]+\z`, MessageFunc(func(MessageContext) string { return "x" })),
+		"file":   File().Dimensions(&ImageDimensions{MinWidth: 10}),
+	}})
+	for _, want := range []string{
+		"// server-only: exists orders.id",
+		"// server-only: greaterThan min_qty",
+		`// server-only: regex \A[a-z]+\z`,
+		"// server-only: required message",
+		"  custom: z.unknown().nullish(), // server-only: custom validator",
+		"// server-only: dimensions",
+		"// server-only: regex \\A[a-z-]+\\z\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Missing %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestJSRegex(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{`^[a-z]+/[0-9]+$`, `/^[a-z]+\/[0-9]+$/`},
+		{`(?i)^abc$`, `/^abc$/i`},
+		{`^\pL+$`, `/^\p{L}+$/u`},
+		{`^[\p{Lu}\PN]$`, `/^[\p{Lu}\P{N}]$/u`},
+		{`^\p{Greek}\p{^Latin}$`, `/^\p{Script=Greek}\P{Script=Latin}$/u`},
+		{`^\x{1F600}\#$`, `/^\u{1F600}#$/u`},
+		{`^\#$`, `/^\#$/`},
+		{`^\p{Bogus}$`, ""},
+		{`\Aabc\z`, ""},
+	}
+	for _, tt := range tests {
+		if got := jsRegex(tt.pattern); got != tt.want {
+			t.Errorf("jsRegex(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestToTypeScript(t *testing.T) {
+	out := ToTypeScript(map[string]Schema{"User": {
+		"email":   String().Required(),
+		"role":    String().Required().In("admin", "user"),
+		"age":     Int().Nullable(),
+		"status":  Enum("draft", "published").Default("draft"),
+		"tags":    Array().Of(String()),
+		"ids":     Array().Of(Union(String(), Int())),
+		"address": Object().Required().Shape(Schema{"city": String().Required()}),
+		"avatar":  File(),
+		"terms":   Bool().Required().True(),
+		"meta":    Any(),
+	}})
+	want := `export interface User {
+  address: {
+    city: string;
+  };
+  age?: number | null;
+  avatar?: File | null;
+  email: string;
+  ids?: Array<string | number> | null;
+  meta?: any | null;
+  role: "admin" | "user";
+  status: "draft" | "published";
+  tags?: string[] | null;
+  terms: true;
+}
+`
+	if out != want {
+		t.Errorf("Unexpected output:\n%s\nwant:\n%s", out, want)
+	}
+}